@@ -0,0 +1,981 @@
+//
+//Copyright 2026 The Kubernetes Authors.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// To regenerate api.pb.go and api_grpc.pb.go run `make generate`.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: pkg/apis/drain/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PreviewDrainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The node to preview. Defaults to the node the driver runs on.
+	NodeName      string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDrainRequest) Reset() {
+	*x = PreviewDrainRequest{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDrainRequest) ProtoMessage() {}
+
+func (x *PreviewDrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDrainRequest.ProtoReflect.Descriptor instead.
+func (*PreviewDrainRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewDrainRequest) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+type PodReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodReference) Reset() {
+	*x = PodReference{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodReference) ProtoMessage() {}
+
+func (x *PodReference) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodReference.ProtoReflect.Descriptor instead.
+func (*PodReference) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PodReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PodReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SkippedPod struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pod   *PodReference          `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
+	// Why the pod would not be evicted, e.g. "daemonset-pod".
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedPod) Reset() {
+	*x = SkippedPod{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedPod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedPod) ProtoMessage() {}
+
+func (x *SkippedPod) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedPod.ProtoReflect.Descriptor instead.
+func (*SkippedPod) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SkippedPod) GetPod() *PodReference {
+	if x != nil {
+		return x.Pod
+	}
+	return nil
+}
+
+func (x *SkippedPod) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PreviewDrainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeName      string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Evictable     []*PodReference        `protobuf:"bytes,2,rep,name=evictable,proto3" json:"evictable,omitempty"`
+	Skipped       []*SkippedPod          `protobuf:"bytes,3,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDrainResponse) Reset() {
+	*x = PreviewDrainResponse{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDrainResponse) ProtoMessage() {}
+
+func (x *PreviewDrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDrainResponse.ProtoReflect.Descriptor instead.
+func (*PreviewDrainResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PreviewDrainResponse) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *PreviewDrainResponse) GetEvictable() []*PodReference {
+	if x != nil {
+		return x.Evictable
+	}
+	return nil
+}
+
+func (x *PreviewDrainResponse) GetSkipped() []*SkippedPod {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type PauseDrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseDrainRequest) Reset() {
+	*x = PauseDrainRequest{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseDrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseDrainRequest) ProtoMessage() {}
+
+func (x *PauseDrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseDrainRequest.ProtoReflect.Descriptor instead.
+func (*PauseDrainRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+type ResumeDrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeDrainRequest) Reset() {
+	*x = ResumeDrainRequest{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeDrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeDrainRequest) ProtoMessage() {}
+
+func (x *ResumeDrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeDrainRequest.ProtoReflect.Descriptor instead.
+func (*ResumeDrainRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+type PauseStateResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Paused bool                   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The event currently being drained, empty if none.
+	ActiveEvent   string `protobuf:"bytes,2,opt,name=active_event,json=activeEvent,proto3" json:"active_event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseStateResponse) Reset() {
+	*x = PauseStateResponse{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseStateResponse) ProtoMessage() {}
+
+func (x *PauseStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseStateResponse.ProtoReflect.Descriptor instead.
+func (*PauseStateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PauseStateResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *PauseStateResponse) GetActiveEvent() string {
+	if x != nil {
+		return x.ActiveEvent
+	}
+	return ""
+}
+
+type ListRecentDrainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecentDrainsRequest) Reset() {
+	*x = ListRecentDrainsRequest{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecentDrainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentDrainsRequest) ProtoMessage() {}
+
+func (x *ListRecentDrainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentDrainsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecentDrainsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+type DrainRecord struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	NodeName  string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	EventName string                 `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// The terminal condition reported, e.g. "drain-complete".
+	Condition string `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The error reported with the condition, empty on success.
+	Error    string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Started  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started,proto3" json:"started,omitempty"`
+	Finished *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished,proto3" json:"finished,omitempty"`
+	Evicted  int32                  `protobuf:"varint,7,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	Failed   int32                  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The last eviction error for each pod, keyed by namespace/name.
+	EvictionErrors map[string]string `protobuf:"bytes,9,rep,name=eviction_errors,json=evictionErrors,proto3" json:"eviction_errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// API server warnings returned for pods that were evicted, keyed by
+	// namespace/name.
+	EvictionWarnings map[string]string `protobuf:"bytes,10,rep,name=eviction_warnings,json=evictionWarnings,proto3" json:"eviction_warnings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DrainRecord) Reset() {
+	*x = DrainRecord{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRecord) ProtoMessage() {}
+
+func (x *DrainRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRecord.ProtoReflect.Descriptor instead.
+func (*DrainRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DrainRecord) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *DrainRecord) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *DrainRecord) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *DrainRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DrainRecord) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *DrainRecord) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *DrainRecord) GetEvicted() int32 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+func (x *DrainRecord) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *DrainRecord) GetEvictionErrors() map[string]string {
+	if x != nil {
+		return x.EvictionErrors
+	}
+	return nil
+}
+
+func (x *DrainRecord) GetEvictionWarnings() map[string]string {
+	if x != nil {
+		return x.EvictionWarnings
+	}
+	return nil
+}
+
+type ListRecentDrainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drains        []*DrainRecord         `protobuf:"bytes,1,rep,name=drains,proto3" json:"drains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecentDrainsResponse) Reset() {
+	*x = ListRecentDrainsResponse{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecentDrainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentDrainsResponse) ProtoMessage() {}
+
+func (x *ListRecentDrainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentDrainsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecentDrainsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRecentDrainsResponse) GetDrains() []*DrainRecord {
+	if x != nil {
+		return x.Drains
+	}
+	return nil
+}
+
+type AbortTransitionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EventName string                 `protobuf:"bytes,1,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// Uncordon the node after stopping the drain.
+	Uncordon      bool `protobuf:"varint,2,opt,name=uncordon,proto3" json:"uncordon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortTransitionRequest) Reset() {
+	*x = AbortTransitionRequest{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortTransitionRequest) ProtoMessage() {}
+
+func (x *AbortTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortTransitionRequest.ProtoReflect.Descriptor instead.
+func (*AbortTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AbortTransitionRequest) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *AbortTransitionRequest) GetUncordon() bool {
+	if x != nil {
+		return x.Uncordon
+	}
+	return false
+}
+
+type AbortTransitionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False if the event was not being drained.
+	Aborted       bool   `protobuf:"varint,1,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	NodeName      string `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Uncordoned    bool   `protobuf:"varint,3,opt,name=uncordoned,proto3" json:"uncordoned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortTransitionResponse) Reset() {
+	*x = AbortTransitionResponse{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortTransitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortTransitionResponse) ProtoMessage() {}
+
+func (x *AbortTransitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortTransitionResponse.ProtoReflect.Descriptor instead.
+func (*AbortTransitionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AbortTransitionResponse) GetAborted() bool {
+	if x != nil {
+		return x.Aborted
+	}
+	return false
+}
+
+func (x *AbortTransitionResponse) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *AbortTransitionResponse) GetUncordoned() bool {
+	if x != nil {
+		return x.Uncordoned
+	}
+	return false
+}
+
+type ListSupportedTransitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportedTransitionsRequest) Reset() {
+	*x = ListSupportedTransitionsRequest{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportedTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedTransitionsRequest) ProtoMessage() {}
+
+func (x *ListSupportedTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSupportedTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{12}
+}
+
+type SupportedTransition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The condition StartLifecycleTransition reports, e.g. "drain-started".
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// The condition EndLifecycleTransition reports on success, e.g.
+	// "drain-complete".
+	End           string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportedTransition) Reset() {
+	*x = SupportedTransition{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportedTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportedTransition) ProtoMessage() {}
+
+func (x *SupportedTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportedTransition.ProtoReflect.Descriptor instead.
+func (*SupportedTransition) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SupportedTransition) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *SupportedTransition) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type ListSupportedTransitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transitions   []*SupportedTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportedTransitionsResponse) Reset() {
+	*x = ListSupportedTransitionsResponse{}
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportedTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedTransitionsResponse) ProtoMessage() {}
+
+func (x *ListSupportedTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_drain_v1alpha1_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSupportedTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSupportedTransitionsResponse) GetTransitions() []*SupportedTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+var File_pkg_apis_drain_v1alpha1_api_proto protoreflect.FileDescriptor
+
+const file_pkg_apis_drain_v1alpha1_api_proto_rawDesc = "" +
+	"\n" +
+	"!pkg/apis/drain/v1alpha1/api.proto\x12\x13kssd.drain.v1alpha1\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
+	"\x13PreviewDrainRequest\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\"@\n" +
+	"\fPodReference\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"Y\n" +
+	"\n" +
+	"SkippedPod\x123\n" +
+	"\x03pod\x18\x01 \x01(\v2!.kssd.drain.v1alpha1.PodReferenceR\x03pod\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xaf\x01\n" +
+	"\x14PreviewDrainResponse\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\x12?\n" +
+	"\tevictable\x18\x02 \x03(\v2!.kssd.drain.v1alpha1.PodReferenceR\tevictable\x129\n" +
+	"\askipped\x18\x03 \x03(\v2\x1f.kssd.drain.v1alpha1.SkippedPodR\askipped\"\x13\n" +
+	"\x11PauseDrainRequest\"\x14\n" +
+	"\x12ResumeDrainRequest\"O\n" +
+	"\x12PauseStateResponse\x12\x16\n" +
+	"\x06paused\x18\x01 \x01(\bR\x06paused\x12!\n" +
+	"\factive_event\x18\x02 \x01(\tR\vactiveEvent\"\x19\n" +
+	"\x17ListRecentDrainsRequest\"\xe9\x04\n" +
+	"\vDrainRecord\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x02 \x01(\tR\teventName\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x124\n" +
+	"\astarted\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\astarted\x126\n" +
+	"\bfinished\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfinished\x12\x18\n" +
+	"\aevicted\x18\a \x01(\x05R\aevicted\x12\x16\n" +
+	"\x06failed\x18\b \x01(\x05R\x06failed\x12]\n" +
+	"\x0feviction_errors\x18\t \x03(\v24.kssd.drain.v1alpha1.DrainRecord.EvictionErrorsEntryR\x0eevictionErrors\x12c\n" +
+	"\x11eviction_warnings\x18\n" +
+	" \x03(\v26.kssd.drain.v1alpha1.DrainRecord.EvictionWarningsEntryR\x10evictionWarnings\x1aA\n" +
+	"\x13EvictionErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aC\n" +
+	"\x15EvictionWarningsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
+	"\x18ListRecentDrainsResponse\x128\n" +
+	"\x06drains\x18\x01 \x03(\v2 .kssd.drain.v1alpha1.DrainRecordR\x06drains\"S\n" +
+	"\x16AbortTransitionRequest\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x01 \x01(\tR\teventName\x12\x1a\n" +
+	"\buncordon\x18\x02 \x01(\bR\buncordon\"p\n" +
+	"\x17AbortTransitionResponse\x12\x18\n" +
+	"\aaborted\x18\x01 \x01(\bR\aaborted\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12\x1e\n" +
+	"\n" +
+	"uncordoned\x18\x03 \x01(\bR\n" +
+	"uncordoned\"!\n" +
+	"\x1fListSupportedTransitionsRequest\"=\n" +
+	"\x13SupportedTransition\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"n\n" +
+	" ListSupportedTransitionsResponse\x12J\n" +
+	"\vtransitions\x18\x01 \x03(\v2(.kssd.drain.v1alpha1.SupportedTransitionR\vtransitions2\xa8\x05\n" +
+	"\fDrainControl\x12e\n" +
+	"\fPreviewDrain\x12(.kssd.drain.v1alpha1.PreviewDrainRequest\x1a).kssd.drain.v1alpha1.PreviewDrainResponse\"\x00\x12_\n" +
+	"\n" +
+	"PauseDrain\x12&.kssd.drain.v1alpha1.PauseDrainRequest\x1a'.kssd.drain.v1alpha1.PauseStateResponse\"\x00\x12a\n" +
+	"\vResumeDrain\x12'.kssd.drain.v1alpha1.ResumeDrainRequest\x1a'.kssd.drain.v1alpha1.PauseStateResponse\"\x00\x12q\n" +
+	"\x10ListRecentDrains\x12,.kssd.drain.v1alpha1.ListRecentDrainsRequest\x1a-.kssd.drain.v1alpha1.ListRecentDrainsResponse\"\x00\x12n\n" +
+	"\x0fAbortTransition\x12+.kssd.drain.v1alpha1.AbortTransitionRequest\x1a,.kssd.drain.v1alpha1.AbortTransitionResponse\"\x00\x12\x89\x01\n" +
+	"\x18ListSupportedTransitions\x124.kssd.drain.v1alpha1.ListSupportedTransitionsRequest\x1a5.kssd.drain.v1alpha1.ListSupportedTransitionsResponse\"\x00B:Z8k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1b\x06proto3"
+
+var (
+	file_pkg_apis_drain_v1alpha1_api_proto_rawDescOnce sync.Once
+	file_pkg_apis_drain_v1alpha1_api_proto_rawDescData []byte
+)
+
+func file_pkg_apis_drain_v1alpha1_api_proto_rawDescGZIP() []byte {
+	file_pkg_apis_drain_v1alpha1_api_proto_rawDescOnce.Do(func() {
+		file_pkg_apis_drain_v1alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_apis_drain_v1alpha1_api_proto_rawDesc), len(file_pkg_apis_drain_v1alpha1_api_proto_rawDesc)))
+	})
+	return file_pkg_apis_drain_v1alpha1_api_proto_rawDescData
+}
+
+var file_pkg_apis_drain_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_pkg_apis_drain_v1alpha1_api_proto_goTypes = []any{
+	(*PreviewDrainRequest)(nil),              // 0: kssd.drain.v1alpha1.PreviewDrainRequest
+	(*PodReference)(nil),                     // 1: kssd.drain.v1alpha1.PodReference
+	(*SkippedPod)(nil),                       // 2: kssd.drain.v1alpha1.SkippedPod
+	(*PreviewDrainResponse)(nil),             // 3: kssd.drain.v1alpha1.PreviewDrainResponse
+	(*PauseDrainRequest)(nil),                // 4: kssd.drain.v1alpha1.PauseDrainRequest
+	(*ResumeDrainRequest)(nil),               // 5: kssd.drain.v1alpha1.ResumeDrainRequest
+	(*PauseStateResponse)(nil),               // 6: kssd.drain.v1alpha1.PauseStateResponse
+	(*ListRecentDrainsRequest)(nil),          // 7: kssd.drain.v1alpha1.ListRecentDrainsRequest
+	(*DrainRecord)(nil),                      // 8: kssd.drain.v1alpha1.DrainRecord
+	(*ListRecentDrainsResponse)(nil),         // 9: kssd.drain.v1alpha1.ListRecentDrainsResponse
+	(*AbortTransitionRequest)(nil),           // 10: kssd.drain.v1alpha1.AbortTransitionRequest
+	(*AbortTransitionResponse)(nil),          // 11: kssd.drain.v1alpha1.AbortTransitionResponse
+	(*ListSupportedTransitionsRequest)(nil),  // 12: kssd.drain.v1alpha1.ListSupportedTransitionsRequest
+	(*SupportedTransition)(nil),              // 13: kssd.drain.v1alpha1.SupportedTransition
+	(*ListSupportedTransitionsResponse)(nil), // 14: kssd.drain.v1alpha1.ListSupportedTransitionsResponse
+	nil,                                      // 15: kssd.drain.v1alpha1.DrainRecord.EvictionErrorsEntry
+	nil,                                      // 16: kssd.drain.v1alpha1.DrainRecord.EvictionWarningsEntry
+	(*timestamppb.Timestamp)(nil),            // 17: google.protobuf.Timestamp
+}
+var file_pkg_apis_drain_v1alpha1_api_proto_depIdxs = []int32{
+	1,  // 0: kssd.drain.v1alpha1.SkippedPod.pod:type_name -> kssd.drain.v1alpha1.PodReference
+	1,  // 1: kssd.drain.v1alpha1.PreviewDrainResponse.evictable:type_name -> kssd.drain.v1alpha1.PodReference
+	2,  // 2: kssd.drain.v1alpha1.PreviewDrainResponse.skipped:type_name -> kssd.drain.v1alpha1.SkippedPod
+	17, // 3: kssd.drain.v1alpha1.DrainRecord.started:type_name -> google.protobuf.Timestamp
+	17, // 4: kssd.drain.v1alpha1.DrainRecord.finished:type_name -> google.protobuf.Timestamp
+	15, // 5: kssd.drain.v1alpha1.DrainRecord.eviction_errors:type_name -> kssd.drain.v1alpha1.DrainRecord.EvictionErrorsEntry
+	16, // 6: kssd.drain.v1alpha1.DrainRecord.eviction_warnings:type_name -> kssd.drain.v1alpha1.DrainRecord.EvictionWarningsEntry
+	8,  // 7: kssd.drain.v1alpha1.ListRecentDrainsResponse.drains:type_name -> kssd.drain.v1alpha1.DrainRecord
+	13, // 8: kssd.drain.v1alpha1.ListSupportedTransitionsResponse.transitions:type_name -> kssd.drain.v1alpha1.SupportedTransition
+	0,  // 9: kssd.drain.v1alpha1.DrainControl.PreviewDrain:input_type -> kssd.drain.v1alpha1.PreviewDrainRequest
+	4,  // 10: kssd.drain.v1alpha1.DrainControl.PauseDrain:input_type -> kssd.drain.v1alpha1.PauseDrainRequest
+	5,  // 11: kssd.drain.v1alpha1.DrainControl.ResumeDrain:input_type -> kssd.drain.v1alpha1.ResumeDrainRequest
+	7,  // 12: kssd.drain.v1alpha1.DrainControl.ListRecentDrains:input_type -> kssd.drain.v1alpha1.ListRecentDrainsRequest
+	10, // 13: kssd.drain.v1alpha1.DrainControl.AbortTransition:input_type -> kssd.drain.v1alpha1.AbortTransitionRequest
+	12, // 14: kssd.drain.v1alpha1.DrainControl.ListSupportedTransitions:input_type -> kssd.drain.v1alpha1.ListSupportedTransitionsRequest
+	3,  // 15: kssd.drain.v1alpha1.DrainControl.PreviewDrain:output_type -> kssd.drain.v1alpha1.PreviewDrainResponse
+	6,  // 16: kssd.drain.v1alpha1.DrainControl.PauseDrain:output_type -> kssd.drain.v1alpha1.PauseStateResponse
+	6,  // 17: kssd.drain.v1alpha1.DrainControl.ResumeDrain:output_type -> kssd.drain.v1alpha1.PauseStateResponse
+	9,  // 18: kssd.drain.v1alpha1.DrainControl.ListRecentDrains:output_type -> kssd.drain.v1alpha1.ListRecentDrainsResponse
+	11, // 19: kssd.drain.v1alpha1.DrainControl.AbortTransition:output_type -> kssd.drain.v1alpha1.AbortTransitionResponse
+	14, // 20: kssd.drain.v1alpha1.DrainControl.ListSupportedTransitions:output_type -> kssd.drain.v1alpha1.ListSupportedTransitionsResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_pkg_apis_drain_v1alpha1_api_proto_init() }
+func file_pkg_apis_drain_v1alpha1_api_proto_init() {
+	if File_pkg_apis_drain_v1alpha1_api_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_apis_drain_v1alpha1_api_proto_rawDesc), len(file_pkg_apis_drain_v1alpha1_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_apis_drain_v1alpha1_api_proto_goTypes,
+		DependencyIndexes: file_pkg_apis_drain_v1alpha1_api_proto_depIdxs,
+		MessageInfos:      file_pkg_apis_drain_v1alpha1_api_proto_msgTypes,
+	}.Build()
+	File_pkg_apis_drain_v1alpha1_api_proto = out.File
+	file_pkg_apis_drain_v1alpha1_api_proto_goTypes = nil
+	file_pkg_apis_drain_v1alpha1_api_proto_depIdxs = nil
+}
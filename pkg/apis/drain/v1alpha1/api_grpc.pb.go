@@ -0,0 +1,368 @@
+//
+//Copyright 2026 The Kubernetes Authors.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// To regenerate api.pb.go and api_grpc.pb.go run `make generate`.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: pkg/apis/drain/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DrainControl_PreviewDrain_FullMethodName             = "/kssd.drain.v1alpha1.DrainControl/PreviewDrain"
+	DrainControl_PauseDrain_FullMethodName               = "/kssd.drain.v1alpha1.DrainControl/PauseDrain"
+	DrainControl_ResumeDrain_FullMethodName              = "/kssd.drain.v1alpha1.DrainControl/ResumeDrain"
+	DrainControl_ListRecentDrains_FullMethodName         = "/kssd.drain.v1alpha1.DrainControl/ListRecentDrains"
+	DrainControl_AbortTransition_FullMethodName          = "/kssd.drain.v1alpha1.DrainControl/AbortTransition"
+	DrainControl_ListSupportedTransitions_FullMethodName = "/kssd.drain.v1alpha1.DrainControl/ListSupportedTransitions"
+)
+
+// DrainControlClient is the client API for DrainControl service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DrainControl is served by the drain driver alongside the SLM plugin
+// service. It exposes driver-specific operations that are not part of
+// the SLM API, for operators and tooling.
+type DrainControlClient interface {
+	// PreviewDrain reports which pods a drain of the node would evict and
+	// why every other pod would be skipped. It has no side effects.
+	PreviewDrain(ctx context.Context, in *PreviewDrainRequest, opts ...grpc.CallOption) (*PreviewDrainResponse, error)
+	// PauseDrain stops the driver from issuing new evictions. A drain in
+	// progress reports drain-paused until it is resumed.
+	PauseDrain(ctx context.Context, in *PauseDrainRequest, opts ...grpc.CallOption) (*PauseStateResponse, error)
+	// ResumeDrain lets a paused drain continue where it left off.
+	ResumeDrain(ctx context.Context, in *ResumeDrainRequest, opts ...grpc.CallOption) (*PauseStateResponse, error)
+	// ListRecentDrains returns the most recently finished drains, newest
+	// first. Only a fixed number are kept, in memory, so the list is empty
+	// after a driver restart.
+	ListRecentDrains(ctx context.Context, in *ListRecentDrainsRequest, opts ...grpc.CallOption) (*ListRecentDrainsResponse, error)
+	// AbortTransition abandons the drain for an event: it stops eviction,
+	// clears the driver's state for the event and, if asked, uncordons the
+	// node. The kubelet's next EndLifecycleTransition call for the event
+	// gets drain-failed. Aborting an event that is not being drained is a
+	// no-op.
+	AbortTransition(ctx context.Context, in *AbortTransitionRequest, opts ...grpc.CallOption) (*AbortTransitionResponse, error)
+	// ListSupportedTransitions returns the start and end conditions of every
+	// transition the driver handles, so a controller can check compatibility
+	// before driving one.
+	ListSupportedTransitions(ctx context.Context, in *ListSupportedTransitionsRequest, opts ...grpc.CallOption) (*ListSupportedTransitionsResponse, error)
+}
+
+type drainControlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDrainControlClient(cc grpc.ClientConnInterface) DrainControlClient {
+	return &drainControlClient{cc}
+}
+
+func (c *drainControlClient) PreviewDrain(ctx context.Context, in *PreviewDrainRequest, opts ...grpc.CallOption) (*PreviewDrainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewDrainResponse)
+	err := c.cc.Invoke(ctx, DrainControl_PreviewDrain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drainControlClient) PauseDrain(ctx context.Context, in *PauseDrainRequest, opts ...grpc.CallOption) (*PauseStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseStateResponse)
+	err := c.cc.Invoke(ctx, DrainControl_PauseDrain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drainControlClient) ResumeDrain(ctx context.Context, in *ResumeDrainRequest, opts ...grpc.CallOption) (*PauseStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseStateResponse)
+	err := c.cc.Invoke(ctx, DrainControl_ResumeDrain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drainControlClient) ListRecentDrains(ctx context.Context, in *ListRecentDrainsRequest, opts ...grpc.CallOption) (*ListRecentDrainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecentDrainsResponse)
+	err := c.cc.Invoke(ctx, DrainControl_ListRecentDrains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drainControlClient) AbortTransition(ctx context.Context, in *AbortTransitionRequest, opts ...grpc.CallOption) (*AbortTransitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortTransitionResponse)
+	err := c.cc.Invoke(ctx, DrainControl_AbortTransition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drainControlClient) ListSupportedTransitions(ctx context.Context, in *ListSupportedTransitionsRequest, opts ...grpc.CallOption) (*ListSupportedTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSupportedTransitionsResponse)
+	err := c.cc.Invoke(ctx, DrainControl_ListSupportedTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DrainControlServer is the server API for DrainControl service.
+// All implementations must embed UnimplementedDrainControlServer
+// for forward compatibility.
+//
+// DrainControl is served by the drain driver alongside the SLM plugin
+// service. It exposes driver-specific operations that are not part of
+// the SLM API, for operators and tooling.
+type DrainControlServer interface {
+	// PreviewDrain reports which pods a drain of the node would evict and
+	// why every other pod would be skipped. It has no side effects.
+	PreviewDrain(context.Context, *PreviewDrainRequest) (*PreviewDrainResponse, error)
+	// PauseDrain stops the driver from issuing new evictions. A drain in
+	// progress reports drain-paused until it is resumed.
+	PauseDrain(context.Context, *PauseDrainRequest) (*PauseStateResponse, error)
+	// ResumeDrain lets a paused drain continue where it left off.
+	ResumeDrain(context.Context, *ResumeDrainRequest) (*PauseStateResponse, error)
+	// ListRecentDrains returns the most recently finished drains, newest
+	// first. Only a fixed number are kept, in memory, so the list is empty
+	// after a driver restart.
+	ListRecentDrains(context.Context, *ListRecentDrainsRequest) (*ListRecentDrainsResponse, error)
+	// AbortTransition abandons the drain for an event: it stops eviction,
+	// clears the driver's state for the event and, if asked, uncordons the
+	// node. The kubelet's next EndLifecycleTransition call for the event
+	// gets drain-failed. Aborting an event that is not being drained is a
+	// no-op.
+	AbortTransition(context.Context, *AbortTransitionRequest) (*AbortTransitionResponse, error)
+	// ListSupportedTransitions returns the start and end conditions of every
+	// transition the driver handles, so a controller can check compatibility
+	// before driving one.
+	ListSupportedTransitions(context.Context, *ListSupportedTransitionsRequest) (*ListSupportedTransitionsResponse, error)
+	mustEmbedUnimplementedDrainControlServer()
+}
+
+// UnimplementedDrainControlServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDrainControlServer struct{}
+
+func (UnimplementedDrainControlServer) PreviewDrain(context.Context, *PreviewDrainRequest) (*PreviewDrainResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewDrain not implemented")
+}
+func (UnimplementedDrainControlServer) PauseDrain(context.Context, *PauseDrainRequest) (*PauseStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseDrain not implemented")
+}
+func (UnimplementedDrainControlServer) ResumeDrain(context.Context, *ResumeDrainRequest) (*PauseStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeDrain not implemented")
+}
+func (UnimplementedDrainControlServer) ListRecentDrains(context.Context, *ListRecentDrainsRequest) (*ListRecentDrainsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecentDrains not implemented")
+}
+func (UnimplementedDrainControlServer) AbortTransition(context.Context, *AbortTransitionRequest) (*AbortTransitionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AbortTransition not implemented")
+}
+func (UnimplementedDrainControlServer) ListSupportedTransitions(context.Context, *ListSupportedTransitionsRequest) (*ListSupportedTransitionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSupportedTransitions not implemented")
+}
+func (UnimplementedDrainControlServer) mustEmbedUnimplementedDrainControlServer() {}
+func (UnimplementedDrainControlServer) testEmbeddedByValue()                      {}
+
+// UnsafeDrainControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DrainControlServer will
+// result in compilation errors.
+type UnsafeDrainControlServer interface {
+	mustEmbedUnimplementedDrainControlServer()
+}
+
+func RegisterDrainControlServer(s grpc.ServiceRegistrar, srv DrainControlServer) {
+	// If the following call panics, it indicates UnimplementedDrainControlServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DrainControl_ServiceDesc, srv)
+}
+
+func _DrainControl_PreviewDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrainControlServer).PreviewDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrainControl_PreviewDrain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrainControlServer).PreviewDrain(ctx, req.(*PreviewDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrainControl_PauseDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrainControlServer).PauseDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrainControl_PauseDrain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrainControlServer).PauseDrain(ctx, req.(*PauseDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrainControl_ResumeDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrainControlServer).ResumeDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrainControl_ResumeDrain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrainControlServer).ResumeDrain(ctx, req.(*ResumeDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrainControl_ListRecentDrains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentDrainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrainControlServer).ListRecentDrains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrainControl_ListRecentDrains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrainControlServer).ListRecentDrains(ctx, req.(*ListRecentDrainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrainControl_AbortTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrainControlServer).AbortTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrainControl_AbortTransition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrainControlServer).AbortTransition(ctx, req.(*AbortTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DrainControl_ListSupportedTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportedTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DrainControlServer).ListSupportedTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DrainControl_ListSupportedTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DrainControlServer).ListSupportedTransitions(ctx, req.(*ListSupportedTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DrainControl_ServiceDesc is the grpc.ServiceDesc for DrainControl service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DrainControl_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kssd.drain.v1alpha1.DrainControl",
+	HandlerType: (*DrainControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewDrain",
+			Handler:    _DrainControl_PreviewDrain_Handler,
+		},
+		{
+			MethodName: "PauseDrain",
+			Handler:    _DrainControl_PauseDrain_Handler,
+		},
+		{
+			MethodName: "ResumeDrain",
+			Handler:    _DrainControl_ResumeDrain_Handler,
+		},
+		{
+			MethodName: "ListRecentDrains",
+			Handler:    _DrainControl_ListRecentDrains_Handler,
+		},
+		{
+			MethodName: "AbortTransition",
+			Handler:    _DrainControl_AbortTransition_Handler,
+		},
+		{
+			MethodName: "ListSupportedTransitions",
+			Handler:    _DrainControl_ListSupportedTransitions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apis/drain/v1alpha1/api.proto",
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
+)
+
+// AbortTransition abandons the drain for req.EventName. It stops the
+// background eviction, clears the per-event state and optionally
+// uncordons the node. Unknown events are ignored.
+func (d *DrainService) AbortTransition(ctx context.Context, req *drainpbv1alpha1.AbortTransitionRequest) (*drainpbv1alpha1.AbortTransitionResponse, error) {
+	logger := klog.FromContext(ctx)
+
+	msg := formatError(ErrCodeDrainAborted, "drain aborted: aborted through AbortTransition")
+	d.mu.Lock()
+	event, nodeName, cordonedByUs := d.activeEvent, d.activeNode, d.cordonedByUs
+	if req.GetEventName() == "" || req.GetEventName() != event {
+		d.mu.Unlock()
+		logger.V(3).Info("AbortTransition for an event not being drained, ignoring", "event", req.GetEventName())
+		return &drainpbv1alpha1.AbortTransitionResponse{}, nil
+	}
+	// Record the abort before clearing the drain, so an
+	// EndLifecycleTransition in between can't mistake the event for one
+	// to resume.
+	d.abortedEvent, d.abortedEventError = event, msg
+	d.mu.Unlock()
+	d.finishDrain(nodeName, DrainFailed, msg)
+	logger.Info("Drain aborted through AbortTransition", "event", event, "node", nodeName)
+
+	resp := &drainpbv1alpha1.AbortTransitionResponse{Aborted: true, NodeName: nodeName}
+	if req.GetUncordon() && !cordonedByUs {
+		logger.Info("Not uncordoning node, it was cordoned before the drain started", "node", nodeName)
+	} else if req.GetUncordon() {
+		if err := d.uncordonNode(ctx, nodeName); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "uncordon node %s: %v", nodeName, err)
+		}
+		logger.Info("Node uncordoned after abort", "node", nodeName)
+		resp.Uncordoned = true
+	}
+	return resp, nil
+}
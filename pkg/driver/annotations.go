@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+// FieldManager is the field manager the driver writes nodes and pods
+// as. Labels and annotations are written with server-side apply, so the
+// driver owns exactly the keys it sets.
+const FieldManager = "drain.slm.k8s.io"
+
+// Annotations and labels read or written by the driver.
+const (
+	// GracePeriodAnnotation on a pod overrides the termination grace
+	// period, in seconds, used when that pod is evicted. On a node it
+	// overrides Config.GracePeriod for drains of that node; a pod's own
+	// annotation still wins.
+	GracePeriodAnnotation = "drain.slm.k8s.io/grace-period-seconds"
+	// EvictionStrategyAnnotation on a node overrides
+	// Config.EvictionStrategy for drains of that node.
+	EvictionStrategyAnnotation = "drain.slm.k8s.io/eviction-strategy"
+	// DrainTimeoutAnnotation on a node overrides Config.DrainTimeout, as
+	// a Go duration, for drains of that node.
+	DrainTimeoutAnnotation = "drain.slm.k8s.io/drain-timeout"
+	// DrainDisabledAnnotation is the default Config.DrainDisabledAnnotation:
+	// set to "true" on a node, it makes the driver refuse to drain it.
+	DrainDisabledAnnotation = "drain.slm.k8s.io/disabled"
+
+	// TierAnnotation on a Deployment, StatefulSet or standalone
+	// ReplicaSet sets, as an integer, the tier its pods are evicted in
+	// under Config.EvictByTier. Lower tiers go first.
+	TierAnnotation = "drain.slm.k8s.io/tier"
+
+	// LastDrainedAtAnnotation on a node records, in RFC 3339, when the
+	// driver last completed a drain of it.
+	LastDrainedAtAnnotation = "drain.slm.k8s.io/last-drained-at"
+	// LastDrainEventAnnotation on a node names the LifecycleEvent that
+	// triggered its last completed drain.
+	LastDrainEventAnnotation = "drain.slm.k8s.io/last-drain-event"
+
+	// BlockedPodLabel is set to "true" on pods still blocking a drain
+	// after Config.LabelBlockedPodsAfter, so operators can list them with
+	// a label selector. It is removed again once the pod stops blocking
+	// and when the drain ends.
+	BlockedPodLabel = "drain.slm.k8s.io/blocked"
+
+	// SafeToEvictAnnotation is the cluster-autoscaler annotation marking,
+	// with "false", pods that must not be moved. It is only honored with
+	// Config.RespectSafeToEvict.
+	SafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+)
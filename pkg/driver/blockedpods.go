@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// blockedLabelRemoveTimeout bounds removing BlockedPodLabel from the pods
+// of a finished drain.
+const blockedLabelRemoveTimeout = 10 * time.Second
+
+// labelBlockedPods labels the pods in remaining with BlockedPodLabel once
+// the drain of nodeName has run for Config.LabelBlockedPodsAfter, and
+// removes it from labeled pods no longer among remaining. Each pod is
+// labeled once per drain. It is best-effort: failures are logged and
+// retried on the next call, never returned.
+func (d *DrainService) labelBlockedPods(ctx context.Context, nodeName string, remaining []podInfo) {
+	after := d.config.LabelBlockedPodsAfter
+	if after <= 0 {
+		return
+	}
+	blocking := make(map[string]bool, len(remaining))
+	for _, p := range remaining {
+		blocking[p.key()] = true
+	}
+	d.mu.Lock()
+	started := d.drainStartTime
+	var unlabeled []podInfo
+	for _, p := range remaining {
+		if !d.blockedLabeled[p.key()] {
+			unlabeled = append(unlabeled, p)
+		}
+	}
+	var unblocked []string
+	for key := range d.blockedLabeled {
+		if !blocking[key] {
+			unblocked = append(unblocked, key)
+		}
+	}
+	d.mu.Unlock()
+	for _, key := range d.unlabelBlockedPods(ctx, nodeName, unblocked) {
+		d.mu.Lock()
+		delete(d.blockedLabeled, key)
+		d.mu.Unlock()
+	}
+	if started.IsZero() || time.Since(started) < after || len(unlabeled) == 0 {
+		return
+	}
+
+	logger := klog.FromContext(ctx)
+	for _, p := range unlabeled {
+		pod := corev1ac.Pod(p.Name, p.Namespace).WithLabels(map[string]string{BlockedPodLabel: "true"})
+		_, err := d.kubeClient.CoreV1().Pods(p.Namespace).Apply(ctx, pod, metav1.ApplyOptions{FieldManager: FieldManager, Force: true})
+		if err != nil {
+			logger.Error(err, "Failed to label pod blocking drain", "node", nodeName, "pod", p.key())
+			continue
+		}
+		logger.Info("Labeled pod blocking drain", "node", nodeName, "pod", p.key(), "label", BlockedPodLabel)
+		d.mu.Lock()
+		d.blockedLabeled[p.key()] = true
+		d.mu.Unlock()
+	}
+}
+
+// unlabelBlockedPods removes BlockedPodLabel from the pods with the given
+// namespace/name keys, and returns the keys it no longer needs to remove
+// it from: those it removed it from and those of pods that are gone.
+// Failures are logged.
+func (d *DrainService) unlabelBlockedPods(ctx context.Context, nodeName string, keys []string) []string {
+	patch := []byte(fmt.Sprintf(`{"metadata":{"labels":{%q:null}}}`, BlockedPodLabel))
+	var done []string
+	for _, key := range keys {
+		namespace, name, _ := strings.Cut(key, "/")
+		_, err := d.kubeClient.CoreV1().Pods(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+		if err != nil && !apierrors.IsNotFound(err) {
+			klog.FromContext(ctx).Error(err, "Failed to remove blocked label from pod", "node", nodeName, "pod", key, "label", BlockedPodLabel)
+			continue
+		}
+		done = append(done, key)
+	}
+	return done
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lifecycleapi "k8s.io/api/lifecycle/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// eventDeadline returns the time by which event must finish: its creation
+// timestamp plus the SLA of the transition it runs. It reports false when
+// either is unset, in which case the event imposes no deadline.
+func eventDeadline(event *lifecycleapi.LifecycleEvent, transition *lifecycleapi.LifecycleTransition) (time.Time, bool) {
+	if event == nil || transition == nil {
+		return time.Time{}, false
+	}
+	if event.CreationTimestamp.IsZero() || transition.Spec.Sla == nil || transition.Spec.Sla.Duration <= 0 {
+		return time.Time{}, false
+	}
+	return event.CreationTimestamp.Add(transition.Spec.Sla.Duration), true
+}
+
+// drainDeadline returns the earlier of eventDeadline and started plus
+// drainTimeout, ignoring either when it is zero. A zero time means the
+// drain has no deadline.
+func drainDeadline(eventDeadline, started time.Time, drainTimeout time.Duration) time.Time {
+	if drainTimeout <= 0 || started.IsZero() {
+		return eventDeadline
+	}
+	timeout := started.Add(drainTimeout)
+	if eventDeadline.IsZero() || timeout.Before(eventDeadline) {
+		return timeout
+	}
+	return eventDeadline
+}
+
+// resolveEventDeadline fetches the LifecycleEvent and LifecycleTransition
+// named in a request and returns the event's deadline. A zero time means
+// the event has no deadline.
+func (d *DrainService) resolveEventDeadline(ctx context.Context, eventName, transitionName string) (time.Time, error) {
+	if eventName == "" || transitionName == "" {
+		return time.Time{}, nil
+	}
+	event, err := d.kubeClient.LifecycleV1alpha1().LifecycleEvents().Get(ctx, eventName, metav1.GetOptions{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get lifecycle event %q: %w", eventName, err)
+	}
+	transition, err := d.kubeClient.LifecycleV1alpha1().LifecycleTransitions().Get(ctx, transitionName, metav1.GetOptions{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get lifecycle transition %q: %w", transitionName, err)
+	}
+	deadline, _ := eventDeadline(event, transition)
+	return deadline, nil
+}
+
+// eventDeadlineExceeded reports whether the active drain has run past the
+// deadline of its LifecycleEvent.
+func (d *DrainService) eventDeadlineExceeded() (time.Time, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.eventDeadline.IsZero() {
+		return time.Time{}, false
+	}
+	return d.eventDeadline, !time.Now().Before(d.eventDeadline)
+}
+
+// withDeadline narrows ctx to deadline and returns a cancel func that
+// releases both the new context and the parent's cancel.
+func withDeadline(ctx context.Context, parentCancel context.CancelFunc, deadline time.Time) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return ctx, func() {
+		cancel()
+		parentCancel()
+	}
+}
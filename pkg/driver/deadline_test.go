@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"testing"
+	"time"
+
+	lifecycleapi "k8s.io/api/lifecycle/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventDeadline(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	event := func(created time.Time) *lifecycleapi.LifecycleEvent {
+		return &lifecycleapi.LifecycleEvent{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}}
+	}
+	transition := func(sla time.Duration) *lifecycleapi.LifecycleTransition {
+		return &lifecycleapi.LifecycleTransition{Spec: lifecycleapi.LifecycleTransitionSpec{Sla: &metav1.Duration{Duration: sla}}}
+	}
+
+	tests := []struct {
+		name         string
+		event        *lifecycleapi.LifecycleEvent
+		transition   *lifecycleapi.LifecycleTransition
+		drainTimeout time.Duration
+		wantEvent    time.Time
+		wantOK       bool
+		wantDrain    time.Time
+	}{
+		{
+			name:       "no deadline",
+			event:      event(now),
+			transition: &lifecycleapi.LifecycleTransition{},
+		},
+		{
+			name:       "zero SLA",
+			event:      event(now),
+			transition: transition(0),
+		},
+		{
+			name:       "no creation timestamp",
+			event:      &lifecycleapi.LifecycleEvent{},
+			transition: transition(time.Minute),
+		},
+		{
+			name:       "nil transition",
+			event:      event(now),
+			transition: nil,
+		},
+		{
+			name:       "nil event",
+			event:      nil,
+			transition: transition(time.Minute),
+		},
+		{
+			name:       "deadline ahead",
+			event:      event(now),
+			transition: transition(10 * time.Minute),
+			wantEvent:  now.Add(10 * time.Minute),
+			wantOK:     true,
+			wantDrain:  now.Add(10 * time.Minute),
+		},
+		{
+			name:       "deadline in the past",
+			event:      event(now.Add(-time.Hour)),
+			transition: transition(time.Minute),
+			wantEvent:  now.Add(-59 * time.Minute),
+			wantOK:     true,
+			wantDrain:  now.Add(-59 * time.Minute),
+		},
+		{
+			name:         "event deadline before drain timeout",
+			event:        event(now),
+			transition:   transition(5 * time.Minute),
+			drainTimeout: 10 * time.Minute,
+			wantEvent:    now.Add(5 * time.Minute),
+			wantOK:       true,
+			wantDrain:    now.Add(5 * time.Minute),
+		},
+		{
+			name:         "drain timeout before event deadline",
+			event:        event(now),
+			transition:   transition(10 * time.Minute),
+			drainTimeout: 5 * time.Minute,
+			wantEvent:    now.Add(10 * time.Minute),
+			wantOK:       true,
+			wantDrain:    now.Add(5 * time.Minute),
+		},
+		{
+			name:         "drain timeout only",
+			event:        event(now),
+			transition:   nil,
+			drainTimeout: 5 * time.Minute,
+			wantDrain:    now.Add(5 * time.Minute),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := eventDeadline(tc.event, tc.transition)
+			if !got.Equal(tc.wantEvent) || ok != tc.wantOK {
+				t.Errorf("eventDeadline() = %v, %v, want %v, %v", got, ok, tc.wantEvent, tc.wantOK)
+			}
+			if drain := drainDeadline(got, now, tc.drainTimeout); !drain.Equal(tc.wantDrain) {
+				t.Errorf("drainDeadline() = %v, want %v", drain, tc.wantDrain)
+			}
+		})
+	}
+}
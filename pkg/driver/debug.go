@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// ActiveDrain describes a drain in progress, for the HTTP debug endpoint.
+type ActiveDrain struct {
+	EventName string    `json:"eventName"`
+	NodeName  string    `json:"nodeName"`
+	Started   time.Time `json:"started"`
+	Paused    bool      `json:"paused"`
+	Evicted   int       `json:"evicted"`
+	Failed    int       `json:"failed"`
+	// RemainingPods lists the pods still to leave the node, as
+	// namespace/name.
+	RemainingPods []string `json:"remainingPods"`
+}
+
+// ActiveDrains returns the drains in progress, which is at most one. It
+// returns an empty list when the driver is idle.
+func (d *DrainService) ActiveDrains(ctx context.Context) ([]ActiveDrain, error) {
+	d.mu.Lock()
+	if d.activeEvent == "" {
+		d.mu.Unlock()
+		return []ActiveDrain{}, nil
+	}
+	drain := ActiveDrain{
+		EventName: d.activeEvent,
+		NodeName:  d.activeNode,
+		Started:   d.drainStartTime,
+		Paused:    d.pausedLocked(),
+		Evicted:   d.evictedPods,
+		Failed:    d.failedPods,
+	}
+	d.mu.Unlock()
+
+	remaining, err := d.remainingPods(ctx, drain.NodeName)
+	if err != nil {
+		return nil, err
+	}
+	drain.RemainingPods = make([]string, 0, len(remaining))
+	for _, p := range remaining {
+		drain.RemainingPods = append(drain.RemainingPods, p.key())
+	}
+	return []ActiveDrain{drain}, nil
+}
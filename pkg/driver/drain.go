@@ -21,7 +21,8 @@ limitations under the License.
 // Drain (drain-started → drain-complete):
 //   - StartLifecycleTransition: cordons the node and begins async pod eviction.
 //   - EndLifecycleTransition: watches for remaining evictable pods and returns
-//     drain-complete when all pods are gone.
+//     drain-complete when all pods are gone, or drain-failed if the drain
+//     timeout elapses first.
 //
 // Uncordon (uncordoning → maintenance-complete):
 //   - StartLifecycleTransition: uncordons the node (sets spec.unschedulable = false).
@@ -30,18 +31,43 @@ limitations under the License.
 package driver
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	slmpbv1alpha1 "k8s.io/kubelet/pkg/apis/slm/v1alpha1"
+
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
+	"k8s.io/kubectl-server-side-drain/pkg/metrics"
 )
 
 // Lifecycle condition constants shared between the driver and command package.
@@ -49,38 +75,435 @@ const (
 	// Drain transition conditions.
 	DrainStarted  = "drain-started"
 	DrainComplete = "drain-complete"
+	// DrainFailed is reported when the drain could not complete within
+	// the drain timeout. It is terminal: the kubelet should fail the
+	// transition rather than keep polling.
+	DrainFailed = "drain-failed"
+	// NodeDeleted is reported instead of drain-complete when no pods
+	// remain because the node object itself was deleted mid-drain (e.g.
+	// by a cloud autoscaler).
+	NodeDeleted = "node-deleted"
+	// DrainPaused is reported while eviction is paused through
+	// DrainControl.PauseDrain or SIGUSR1. It is not terminal.
+	DrainPaused = "drain-paused"
+	// SLAExceeded is reported when Config.EnforceEventSLA is set and the
+	// LifecycleEvent's deadline passes before the drain completes. It is
+	// terminal.
+	SLAExceeded = "sla-exceeded"
 
 	// Uncordon transition conditions.
 	Uncordoning         = "uncordoning"
 	MaintenanceComplete = "maintenance-complete"
 )
 
-// evictionGoroutineTimeout for the async eviction.
-const evictionGoroutineTimeout = 10 * time.Minute
+// evictionRetryInterval is the wait before the first retry of a failed
+// eviction when Config.MaxEvictionAttempts allows more than one attempt
+// and the API server sent no Retry-After hint. It doubles per attempt up
+// to maxEvictionRetryInterval.
+const (
+	evictionRetryInterval    = 5 * time.Second
+	maxEvictionRetryInterval = 2 * time.Minute
+)
+
+// maxReportedPods caps how many remaining pods are listed in a drain
+// failure message.
+const maxReportedPods = 10
+
+// EvictionStrategy selects how the driver removes pods from the node.
+type EvictionStrategy string
+
+const (
+	// EvictionStrategyEviction uses the Eviction API for every pod, which
+	// respects PodDisruptionBudgets.
+	EvictionStrategyEviction EvictionStrategy = "eviction"
+	// EvictionStrategyDelete deletes pods owned by a ReplicaSet and lets the
+	// controller recreate them elsewhere. All other pods are still evicted.
+	EvictionStrategyDelete EvictionStrategy = "delete"
+)
+
+// EvictionMode selects who removes pods from the node during a drain.
+type EvictionMode string
+
+const (
+	// EvictionModeAPI has the driver evict or delete each pod itself.
+	EvictionModeAPI EvictionMode = "api"
+	// EvictionModeTaint taints the node with MaintenanceTaintKey:NoExecute
+	// on cordon and leaves evicting to the cluster's taint-based eviction.
+	// The driver only waits for the pods to be gone. Taint-based eviction
+	// deletes every pod that doesn't tolerate the taint, so the driver's
+	// exclusions (namespaces, selectors, owner patterns, unmanaged and
+	// local-storage policies) and PodDisruptionBudgets do not protect any
+	// pod in this mode.
+	EvictionModeTaint EvictionMode = "taint"
+)
+
+// UnmanagedPodPolicy selects what the driver does with pods that have no
+// controller, which nothing recreates once they are evicted.
+type UnmanagedPodPolicy string
+
+const (
+	// UnmanagedPodsEvict evicts unmanaged pods like any other pod.
+	UnmanagedPodsEvict UnmanagedPodPolicy = "evict"
+	// UnmanagedPodsSkip leaves unmanaged pods on the node.
+	UnmanagedPodsSkip UnmanagedPodPolicy = "skip"
+	// UnmanagedPodsIfPDB evicts an unmanaged pod only when a
+	// PodDisruptionBudget covers it, taking the budget as the owner's
+	// consent to disrupt it through the Eviction API.
+	UnmanagedPodsIfPDB UnmanagedPodPolicy = "if-pdb"
+)
+
+// NodeMutationMethod selects the API verb cordon and uncordon write the
+// node with, to match the RBAC the driver is granted.
+type NodeMutationMethod string
+
+const (
+	// NodeMutationPatch sends a JSON merge patch of the node's
+	// unschedulable flag and taints.
+	NodeMutationPatch NodeMutationMethod = "patch"
+	// NodeMutationUpdate sends the whole node back with an update.
+	NodeMutationUpdate NodeMutationMethod = "update"
+)
+
+// ExternalUncordonAction selects how the driver reacts when the node is
+// uncordoned by someone else while a drain is in progress.
+type ExternalUncordonAction string
+
+const (
+	// ExternalUncordonWarn logs a warning and keeps draining.
+	ExternalUncordonWarn ExternalUncordonAction = "warn"
+	// ExternalUncordonRecordon cordons the node again and keeps draining.
+	ExternalUncordonRecordon ExternalUncordonAction = "recordon"
+	// ExternalUncordonAbort stops evicting and fails the drain.
+	ExternalUncordonAbort ExternalUncordonAction = "abort"
+)
+
+// Config holds the tunables for a DrainService.
+type Config struct {
+	// EvictionTimeout bounds each individual eviction or delete call
+	// (0 = no limit). A call that times out counts as a failed eviction
+	// and is retried.
+	EvictionTimeout time.Duration
+	// GracePeriod overrides the pod termination grace period (-1 = use pod default).
+	GracePeriod int64
+	// KeepPreStopGracePeriod stops GracePeriod, or a node's override of
+	// it, from shortening the grace period of a pod with a PreStop hook,
+	// so the hook isn't killed part way through.
+	KeepPreStopGracePeriod bool
+	// NodeOpTimeout bounds each node Get/Update issued by the driver.
+	// Zero disables the bound.
+	NodeOpTimeout time.Duration
+	// MaxConcurrentNodeOps caps how many node API calls, such as the
+	// cordon, uncordon and status patches of all events, are in flight at
+	// once; further calls wait for a slot. Zero disables the cap. It is
+	// fixed when the DrainService is created.
+	MaxConcurrentNodeOps int
+	// NodeUpdateAttempts bounds how often cordon and uncordon retry a
+	// node update that lost a conflict, with jittered exponential backoff
+	// between attempts. Zero or less means one attempt.
+	NodeUpdateAttempts int
+	// NodeMutationMethod selects how cordon and uncordon write the node.
+	// Empty means NodeMutationPatch.
+	NodeMutationMethod NodeMutationMethod
+	// PodListAttempts bounds how often listing the node's pods is tried
+	// when it fails with a transient API error, with a short backoff in
+	// between. Zero or less means one attempt.
+	PodListAttempts int
+	// DrainTimeout is the overall deadline, measured from startDrain,
+	// after which endDrain reports DrainFailed. Zero waits forever.
+	DrainTimeout time.Duration
+	// EvictionStrategy selects between the Eviction API and plain deletes
+	// for controller-managed pods. Empty means EvictionStrategyEviction.
+	EvictionStrategy EvictionStrategy
+	// EvictionMode selects whether the driver evicts pods itself, the
+	// default, or lets a NoExecute taint evict them.
+	EvictionMode EvictionMode
+	// WatchPods makes startDrain watch the node's pods so endDrain reads
+	// the remaining pods from a local cache instead of listing each tick.
+	WatchPods bool
+	// UncordonAfterDrain makes endDrain uncordon the node once the drain
+	// completes, collapsing drain and maintenance-complete into one step.
+	UncordonAfterDrain bool
+	// ExcludedNamespaces lists namespaces whose pods are never evicted.
+	ExcludedNamespaces sets.Set[string]
+	// PodSelector restricts eviction to pods whose labels match. Nil
+	// selects every pod.
+	PodSelector labels.Selector
+	// ExcludeOwnerPatterns are globs, as in path.Match, for the names of
+	// owners whose pods are never evicted, e.g. "database-*".
+	ExcludeOwnerPatterns []string
+	// EvictionStartJitter is the upper bound of a random delay applied
+	// before the background eviction starts, so drains triggered on many
+	// nodes at once don't hit the API server in lockstep.
+	EvictionStartJitter time.Duration
+	// CordonSettleDelay is how long the background eviction waits after
+	// the cordon, before any jitter, so the scheduler stops placing pods
+	// on the node before they are evicted.
+	CordonSettleDelay time.Duration
+	// CordonConfirmTimeout, if positive, makes startDrain wait, for at
+	// most this long, until the API server's watch cache shows the node
+	// cordoned before it reports drain-started. Informers, including the
+	// scheduler's, are fed from that cache.
+	CordonConfirmTimeout time.Duration
+	// EvictionPasses bounds how many list+evict sweeps the background
+	// eviction makes. Sweeps after the first only evict pods that were
+	// not on the node before.
+	EvictionPasses int
+	// PodFieldSelector is ANDed with the spec.nodeName selector when
+	// listing the node's pods, pushing extra filtering to the API server.
+	// Nil adds no extra terms.
+	PodFieldSelector fields.Selector
+	// WatchNode makes startDrain watch the target node so the driver
+	// notices if it is uncordoned externally mid-drain.
+	WatchNode bool
+	// ExternalUncordonAction is applied when WatchNode observes an
+	// external uncordon. Empty means ExternalUncordonWarn.
+	ExternalUncordonAction ExternalUncordonAction
+	// EnforceEventSLA derives a hard deadline from the LifecycleEvent's
+	// creation time plus its transition's SLA. Eviction work stops at the
+	// deadline and endDrain reports SLAExceeded past it.
+	EnforceEventSLA bool
+	// LogEvictionEvents makes startDrain watch pod Events and log those
+	// about evicted pods and FailedScheduling in their namespaces.
+	LogEvictionEvents bool
+	// MinPodAge defers evicting pods that started less than this long
+	// ago. They still count as remaining, and are evicted once they age
+	// in. Zero evicts pods regardless of age.
+	MinPodAge time.Duration
+	// RespectSafeToEvict skips pods annotated with the cluster-autoscaler
+	// SafeToEvictAnnotation set to "false".
+	RespectSafeToEvict bool
+	// SkipCriticalPods skips pods with the system-node-critical or
+	// system-cluster-critical priority class.
+	SkipCriticalPods bool
+	// DrainDaemonSets evicts DaemonSet pods too, for nodes that are going
+	// away for good. The DaemonSet controller recreates them on the node
+	// until it is deleted.
+	DrainDaemonSets bool
+	// UnmanagedPods selects what to do with pods that have no controller.
+	// Empty means UnmanagedPodsEvict.
+	UnmanagedPods UnmanagedPodPolicy
+	// StandaloneReplicaSetsUnmanaged makes UnmanagedPods also apply to
+	// pods of ReplicaSets that no Deployment controls.
+	StandaloneReplicaSetsUnmanaged bool
+	// DrainDisabledAnnotation names the node annotation that, set to
+	// "true", makes startDrain fail instead of cordoning the node. Empty
+	// disables the check.
+	DrainDisabledAnnotation string
+	// WaitForDaemonSetTermination makes the drain wait until DaemonSet
+	// pods already being deleted are gone. They are still not evicted.
+	WaitForDaemonSetTermination bool
+	// WaitForTerminating makes the drain wait until pods already being
+	// deleted are gone, including ones held by a finalizer, instead of
+	// skipping them. Pods skipped for another reason are not waited for.
+	WaitForTerminating bool
+	// ReportDrainCondition maintains the DrainInProgressCondition in the
+	// node status while draining.
+	ReportDrainCondition bool
+	// EvictionBatchSize, if positive, evicts pods in batches of this many
+	// with EvictionBatchDelay between batches.
+	EvictionBatchSize  int
+	EvictionBatchDelay time.Duration
+	// VerifyRescheduleTimeout, if positive, checks that each evicted
+	// controller-owned pod gets a replacement scheduled to another node
+	// within this long, and records a warning if not.
+	VerifyRescheduleTimeout time.Duration
+	// EvictionLogSummary replaces the per-pod eviction logs with a
+	// summary per sweep. Pods that exhaust their attempts are still
+	// logged individually.
+	EvictionLogSummary bool
+	// EvictLastTolerating, if set, is a taint key: pods that tolerate a
+	// taint with this key are evicted after all other pods.
+	EvictLastTolerating string
+	// RollingEviction evicts a controller's pods on the node one at a
+	// time, waiting up to RollingEvictionTimeout for each replacement to
+	// be Ready on another node before evicting the next.
+	RollingEviction        bool
+	RollingEvictionTimeout time.Duration
+	// DrainFraction, if between 0 and 1, makes a partial drain: only
+	// that fraction of the pods evictable when eviction starts, rounded
+	// up, is evicted, and the drain completes once no more than the rest
+	// remain. The node stays cordoned. Only EvictionModeAPI honors it.
+	DrainFraction float64
+	// EvictByTier evicts pods tier by tier, lowest first, by the
+	// TierAnnotation on their controller. A tier starts once the pods
+	// evicted from the one before have left the node, or TierTimeout
+	// passed. Pods of a tier still being retried hold up the next.
+	EvictByTier bool
+	TierTimeout time.Duration
+	// MinReadySeconds is how long a replacement must have been Ready
+	// before RollingEviction evicts the owner's next pod, so a flapping
+	// replacement doesn't count. Zero accepts any Ready replacement.
+	MinReadySeconds int32
+	// PDBBlockedWarnAfter logs a warning for each pod whose eviction has
+	// been blocked by a PodDisruptionBudget for this long. Zero disables
+	// the warning.
+	PDBBlockedWarnAfter time.Duration
+	// LabelBlockedPodsAfter, if positive, labels pods still remaining
+	// this long after the drain started with BlockedPodLabel.
+	LabelBlockedPodsAfter time.Duration
+	// MaxEvictionAttempts caps how many times the background eviction
+	// tries each pod. Failed pods are retried until the cap, then recorded
+	// as given up. Values below 1 mean a single attempt.
+	MaxEvictionAttempts int
+	// MaxFleetDisruptions caps how many pods all drivers in the cluster
+	// may have disrupted at once; evictions wait for budget. Zero
+	// disables the cap.
+	MaxFleetDisruptions int
+	// FleetBudgetConfigMap is the <namespace>/<name> of the ConfigMap the
+	// drivers coordinate MaxFleetDisruptions through.
+	FleetBudgetConfigMap string
+	// FleetDisruptionTTL bounds how long a disrupted pod holds fleet
+	// budget if its driver never releases it.
+	FleetDisruptionTTL time.Duration
+	// FleetPauseConfigMap, if set, is the <namespace>/<name> of a
+	// ConfigMap every driver watches: while its "paused" key is "true",
+	// eviction is paused as with PauseDrain.
+	FleetPauseConfigMap string
+	// DrainLease keeps a coordination.k8s.io Lease, named by
+	// DrainLeaseName, in DrainLeaseNamespace for the active drain. It is
+	// renewed whenever more pods have been evicted and deleted when the
+	// drain finishes, so a stale Lease marks a stuck drain.
+	DrainLease          bool
+	DrainLeaseNamespace string
+	// PostDrainWebhookURL, if set, is POSTed a JSON summary whenever a
+	// drain reaches drain-complete.
+	PostDrainWebhookURL string
+	// PostDrainWebhookMaintenanceComplete also notifies PostDrainWebhookURL
+	// when an uncordon reaches maintenance-complete.
+	PostDrainWebhookMaintenanceComplete bool
+}
 
 // DrainService implements slmpbv1alpha1.SLMPluginServer with real drain logic.
+// It also implements drainpbv1alpha1.DrainControlServer for driver-specific
+// operations outside the SLM API.
 type DrainService struct {
 	slmpbv1alpha1.UnimplementedSLMPluginServer
+	drainpbv1alpha1.UnimplementedDrainControlServer
+
+	kubeClient  kubernetes.Interface
+	nodeName    string
+	config      Config
+	fleetBudget *fleetBudget // nil unless Config.MaxFleetDisruptions
+
+	// Serializes cordon and uncordon per node name. Never take d.mu
+	// while holding a node lock.
+	nodeLocksMu sync.Mutex
+	nodeLocks   map[string]*sync.Mutex
 
-	kubeClient      kubernetes.Interface
-	nodeName        string
-	evictionTimeout time.Duration
-	gracePeriod     int64 // -1 = use pod default
+	// nodeOps holds a token per node API call in flight, nil unless
+	// Config.MaxConcurrentNodeOps is set.
+	nodeOps chan struct{}
 
 	// Track whether we already started draining for a given event.
 	mu             sync.Mutex
 	activeEvent    string
+	activeNode     string
+	cordonedByUs   bool // startDrain cordoned activeNode, rather than finding it cordoned
+	overrides      nodeOverrides
+	drainStartTime time.Time
 	evictionErrors map[string]string // podKey -> last error
+	// podKey -> API server warnings for the eviction.
+	evictionWarnings map[string]string
+	podWatcher       *podWatcher   // nil unless Config.WatchPods
+	nodeWatcher      *nodeWatcher  // nil unless Config.WatchNode
+	eventWatcher     *eventWatcher // nil unless Config.LogEvictionEvents
+	cancelEviction   context.CancelFunc
+	abortReason      string    // set when the drain was aborted mid-flight
+	abortCode        ErrorCode // reported with abortReason
+	eventDeadline    time.Time // zero unless Config.EnforceEventSLA
+
+	evictedPods int // evictions that succeeded in the active drain
+	failedPods  int // evictions that failed in the active drain
+	// keepPods is how many evictable pods may remain for the active drain
+	// to complete under Config.DrainFraction.
+	keepPods int
+	history  drainHistory
+	// Pods whose eviction was accepted in the active drain.
+	acceptedEvictions map[string]bool
+	// Last status written to DrainInProgressCondition.
+	drainConditionStatus corev1.ConditionStatus
+
+	// Spans of the active drain and uncordon, nil if none.
+	drainSpan    trace.Span
+	uncordonSpan trace.Span
+
+	// First PDB rejection per pod key in the active drain, and the pods
+	// already warned about.
+	pdbBlockedSince  map[string]time.Time
+	pdbBlockedWarned map[string]bool
+
+	// pdbCache holds the PodDisruptionBudgets of each namespace for the
+	// active drain, see namespacePDBs.
+	pdbCache map[string]cachedPDBs
+
+	// standaloneReplicaSets caches, for the active drain, whether each
+	// ReplicaSet owning a pod on the node lacks a controller.
+	standaloneReplicaSets map[types.UID]bool
+
+	// ownerTiers caches, for the active drain, the tier of each
+	// controller owning a pod on the node under Config.EvictByTier.
+	ownerTiers map[types.UID]int
+
+	// ownerSelectors caches, for the active drain, the label selector of
+	// each pod owner looked up by ownerSelector.
+	ownerSelectors map[types.UID]string
+
+	// blockedLabeled holds the pods of the active drain labeled with
+	// BlockedPodLabel, keyed by namespace/name.
+	blockedLabeled map[string]bool
+
+	// leaseEvicted is the evicted pod count when the drain Lease was
+	// last renewed.
+	leaseEvicted int
+
+	// Evicted pods holding fleet budget until they leave the node.
+	fleetHeld map[string]podInfo
+
+	// abortedEvent was abandoned through AbortTransition; its next
+	// endDrain reports DrainFailed with abortedEventError.
+	abortedEvent      string
+	abortedEventError string
+
+	// pendingReload holds a reloaded Config waiting for the active drain
+	// to finish.
+	pendingReload *Config
+
+	// Pause state: paused is set through PauseDrain or SIGUSR1 and
+	// fleetPaused by Config.FleetPauseConfigMap. resumed is closed when
+	// neither is set any more.
+	paused      bool
+	fleetPaused bool
+	resumed     chan struct{}
 }
 
 // NewDrainService creates a new DrainService.
-func NewDrainService(kubeClient kubernetes.Interface, nodeName string, evictionTimeout time.Duration, gracePeriod int64) *DrainService {
+func NewDrainService(kubeClient kubernetes.Interface, nodeName string, config Config) *DrainService {
+	budget, err := newFleetBudget(kubeClient, nodeName, config)
+	if err != nil {
+		klog.Background().Error(err, "Fleet disruption budget disabled")
+	}
+	var nodeOps chan struct{}
+	if config.MaxConcurrentNodeOps > 0 {
+		nodeOps = make(chan struct{}, config.MaxConcurrentNodeOps)
+	}
 	return &DrainService{
-		kubeClient:      kubeClient,
-		nodeName:        nodeName,
-		evictionTimeout: evictionTimeout,
-		gracePeriod:     gracePeriod,
-		evictionErrors:  make(map[string]string),
+		kubeClient:            kubeClient,
+		nodeName:              nodeName,
+		config:                config,
+		fleetBudget:           budget,
+		evictionErrors:        make(map[string]string),
+		evictionWarnings:      make(map[string]string),
+		pdbBlockedSince:       make(map[string]time.Time),
+		pdbBlockedWarned:      make(map[string]bool),
+		blockedLabeled:        make(map[string]bool),
+		standaloneReplicaSets: make(map[types.UID]bool),
+		ownerTiers:            make(map[types.UID]int),
+		ownerSelectors:        make(map[types.UID]string),
+		pdbCache:              make(map[string]cachedPDBs),
+		fleetHeld:             make(map[string]podInfo),
+		acceptedEvictions:     make(map[string]bool),
+		nodeLocks:             make(map[string]*sync.Mutex),
+		nodeOps:               nodeOps,
 	}
 }
 
@@ -126,31 +549,202 @@ func (d *DrainService) startDrain(ctx context.Context, req *slmpbv1alpha1.StartL
 	logger := klog.FromContext(ctx)
 
 	d.mu.Lock()
+	// A kubelet retry of the same event must not re-cordon or start a
+	// second eviction goroutine. A drain whose cordon failed never got
+	// that far, so it is started again.
+	if req.GetEventName() != "" && d.activeEvent == req.GetEventName() && d.cancelEviction != nil {
+		d.mu.Unlock()
+		logger.Info("Drain already in progress for event", "event", req.GetEventName(), "node", targetNode)
+		return &slmpbv1alpha1.LifecycleTransitionResponse{
+			LifecycleCondition: req.GetStart(),
+			NodeName:           targetNode,
+		}, nil
+	}
 	d.activeEvent = req.GetEventName()
+	d.activeNode = targetNode
+	d.drainStartTime = time.Now()
+	endTransitionSpan(d.drainSpan, "superseded", "")
+	d.drainSpan = startTransitionSpan("kssd-drain", targetNode, req.GetEventName())
 	d.evictionErrors = make(map[string]string)
+	d.evictionWarnings = make(map[string]string)
+	d.evictedPods, d.failedPods = 0, 0
+	d.keepPods = 0
+	d.leaseEvicted = 0
+	clear(d.acceptedEvictions)
+	clear(d.pdbBlockedSince)
+	clear(d.pdbBlockedWarned)
+	clear(d.blockedLabeled)
+	clear(d.standaloneReplicaSets)
+	clear(d.ownerTiers)
+	clear(d.ownerSelectors)
+	clear(d.pdbCache)
+	d.abortReason = ""
+	d.abortCode = ""
+	d.eventDeadline = time.Time{}
+	if d.podWatcher != nil {
+		d.podWatcher.stop()
+		d.podWatcher = nil
+	}
 	d.mu.Unlock()
 
+	if msg := d.drainDisabled(ctx, targetNode); msg != "" {
+		// The node is protected on purpose, so a retry would fail too.
+		logger.Info("Node is protected from draining, failing drain", "node", targetNode, "annotation", d.config.DrainDisabledAnnotation)
+		d.finishDrain(targetNode, DrainFailed, msg)
+		return &slmpbv1alpha1.LifecycleTransitionResponse{
+			LifecycleCondition: DrainFailed,
+			NodeName:           targetNode,
+			Error:              msg,
+		}, nil
+	}
+
 	// Cordon the node
-	if err := d.cordonNode(ctx, targetNode); err != nil {
+	cordoned, err := d.cordonNode(ctx, targetNode)
+	if apierrors.IsForbidden(err) {
+		// Retrying can't fix missing RBAC, so fail the transition
+		// instead of having the kubelet retry it forever.
+		logger.Error(err, "Cordon forbidden, failing drain", "node", targetNode)
+		msg := formatError(ErrCodeCordonForbidden, "cordon node forbidden, check RBAC for nodes get and %s: %v", cmp.Or(d.config.NodeMutationMethod, NodeMutationPatch), err)
+		d.finishDrain(targetNode, DrainFailed, msg)
 		return &slmpbv1alpha1.LifecycleTransitionResponse{
-			NodeName: targetNode,
-			Error:    fmt.Sprintf("cordon node: %v", err),
+			LifecycleCondition: DrainFailed,
+			NodeName:           targetNode,
+			Error:              msg,
 		}, nil
 	}
-	logger.Info("Node cordoned", "node", targetNode)
+	if err != nil {
+		return errorResponse(targetNode, ErrCodeCordonFailed, "cordon node: %v", err), nil
+	}
+	d.mu.Lock()
+	d.cordonedByUs = cordoned
+	if d.drainSpan != nil {
+		d.drainSpan.AddEvent("cordoned", trace.WithAttributes(attribute.Bool("kssd.cordoned_by_driver", cordoned)))
+	}
+	d.mu.Unlock()
+	if cordoned {
+		logger.Info("Node cordoned", "node", targetNode)
+	} else {
+		logger.Info("Node was already cordoned, it will not be uncordoned by the driver", "node", targetNode)
+	}
+
+	// Watch the node's pods only once it is cordoned, so a failed cordon
+	// leaves no watch running.
+	if d.config.WatchPods {
+		w := newPodWatcher(d.kubeClient, d.podFieldSelector(targetNode))
+		w.start()
+		d.mu.Lock()
+		d.podWatcher = w
+		d.mu.Unlock()
+	}
+	if cordoned && d.config.CordonConfirmTimeout > 0 {
+		d.confirmCordon(ctx, targetNode)
+	}
+
+	// Apply the node's eviction overrides, if any, for this drain.
+	var overrides nodeOverrides
+	if node, err := d.getNode(ctx, targetNode); err != nil {
+		logger.Error(err, "Could not read node eviction overrides, using defaults", "node", targetNode)
+	} else {
+		overrides, err = parseNodeOverrides(node.Annotations)
+		if err != nil {
+			logger.Error(err, "Ignoring invalid node eviction overrides", "node", targetNode)
+		}
+	}
+	d.mu.Lock()
+	d.overrides = overrides
+	d.mu.Unlock()
+
+	if d.config.DrainLease {
+		d.applyDrainLease(ctx, targetNode)
+	}
+
+	var deadline time.Time
+	if d.config.EnforceEventSLA {
+		var err error
+		deadline, err = d.resolveEventDeadline(ctx, req.GetEventName(), req.GetTransitionName())
+		if err != nil {
+			// Fall back to the process-local timeouts rather than
+			// refusing to drain.
+			logger.Error(err, "Could not resolve event deadline", "node", targetNode)
+		} else if !deadline.IsZero() {
+			logger.Info("Drain bounded by event deadline", "node", targetNode, "deadline", deadline)
+		}
+		d.mu.Lock()
+		d.eventDeadline = deadline
+		d.mu.Unlock()
+	}
+
+	// Watch the node only once it is cordoned, so any later flip back to
+	// schedulable is someone else's doing.
+	if d.config.WatchNode {
+		w := newNodeWatcher(d.kubeClient, targetNode, d.handleExternalUncordon)
+		d.mu.Lock()
+		old := d.nodeWatcher
+		d.nodeWatcher = w
+		d.mu.Unlock()
+		if old != nil {
+			old.stop()
+		}
+		w.start()
+	}
+
+	if d.config.LogEvictionEvents {
+		w := newEventWatcher(d.kubeClient, targetNode)
+		d.mu.Lock()
+		old := d.eventWatcher
+		d.eventWatcher = w
+		d.mu.Unlock()
+		if old != nil {
+			old.stop()
+		}
+	}
 
 	// Start an async eviction so the gRPC call
 	// returns immediately. The kubelet will call EndLifecycleTransition
 	// on the next reconcile which will monitor drain progress.
+	// The eviction has no timeout of its own: it may wait out a pause,
+	// rolling, tier or fleet budget waits for any length of time, and
+	// is bounded only by the drain timeout and the event deadline,
+	// whichever comes first.
+	bgCtx, cancel := context.WithCancel(context.Background())
+	d.mu.Lock()
+	deadline = drainDeadline(deadline, d.drainStartTime, d.drainTimeout())
+	d.mu.Unlock()
+	if !deadline.IsZero() {
+		bgCtx, cancel = withDeadline(bgCtx, cancel, deadline)
+	}
+	d.mu.Lock()
+	d.cancelEviction = cancel
+	d.mu.Unlock()
 	go func() {
-		bgCtx, cancel := context.WithTimeout(context.Background(), evictionGoroutineTimeout)
 		defer cancel()
-		evicted, failed, total := d.evictAllPods(bgCtx, targetNode)
+		if d.config.CordonSettleDelay > 0 {
+			klog.FromContext(bgCtx).V(3).Info("Waiting for the cordon to settle", "node", targetNode, "delay", d.config.CordonSettleDelay)
+			if err := sleepWithContext(bgCtx, d.config.CordonSettleDelay); err != nil {
+				return
+			}
+		}
+		if d.config.EvictionStartJitter > 0 {
+			delay := rand.N(d.config.EvictionStartJitter)
+			klog.FromContext(bgCtx).V(3).Info("Delaying eviction start", "node", targetNode, "delay", delay)
+			if err := sleepWithContext(bgCtx, delay); err != nil {
+				return
+			}
+		}
+		if d.config.EvictionMode == EvictionModeTaint {
+			klog.FromContext(bgCtx).Info("Leaving eviction to the maintenance taint", "node", targetNode, "taint", MaintenanceTaintKey)
+			return
+		}
+		summary := d.evictAllPods(bgCtx, targetNode)
 		klog.FromContext(bgCtx).Info("Background eviction pass complete",
 			"node", targetNode,
-			"total", total,
-			"evicted", evicted,
-			"failed", failed,
+			"total", summary.Total,
+			"evicted", summary.Evicted,
+			"failed", summary.Failed,
+			"pdbBlocked", summary.PDBBlocked,
+			"forbidden", summary.Forbidden,
+			"timedOut", summary.TimedOut,
+			"errors", summary.Errors,
 		)
 	}()
 
@@ -165,11 +759,13 @@ func (d *DrainService) startDrain(ctx context.Context, req *slmpbv1alpha1.StartL
 func (d *DrainService) startUncordon(ctx context.Context, req *slmpbv1alpha1.StartLifecycleTransitionRequest, targetNode string) (*slmpbv1alpha1.LifecycleTransitionResponse, error) {
 	logger := klog.FromContext(ctx)
 
+	d.mu.Lock()
+	if d.uncordonSpan == nil {
+		d.uncordonSpan = startTransitionSpan("kssd-maintenance-complete", targetNode, req.GetEventName())
+	}
+	d.mu.Unlock()
 	if err := d.uncordonNode(ctx, targetNode); err != nil {
-		return &slmpbv1alpha1.LifecycleTransitionResponse{
-			NodeName: targetNode,
-			Error:    fmt.Sprintf("uncordon node: %v", err),
-		}, nil
+		return errorResponse(targetNode, ErrCodeUncordonFailed, "uncordon node: %v", err), nil
 	}
 	logger.Info("Node uncordoned", "node", targetNode)
 
@@ -208,7 +804,16 @@ func (d *DrainService) EndLifecycleTransition(ctx context.Context, req *slmpbv1a
 	case MaintenanceComplete:
 		return d.endUncordon(ctx, req, targetNode)
 	case DrainComplete:
-		return d.endDrain(ctx, req, targetNode)
+		resp, err := d.endDrain(ctx, req, targetNode)
+		if err == nil && resp.GetLifecycleCondition() != req.GetStart() {
+			// Progress ticks report their own remaining count.
+			msg := resp.GetError()
+			if msg == "" {
+				msg = resp.GetLifecycleCondition()
+			}
+			d.reportDrainCondition(ctx, targetNode, resp.GetLifecycleCondition(), msg)
+		}
+		return resp, err
 	default:
 		return nil, fmt.Errorf("driver does not support transition %q in EndLifecycleTransition", transition)
 	}
@@ -218,19 +823,111 @@ func (d *DrainService) EndLifecycleTransition(ctx context.Context, req *slmpbv1a
 func (d *DrainService) endDrain(ctx context.Context, req *slmpbv1alpha1.EndLifecycleTransitionRequest, targetNode string) (*slmpbv1alpha1.LifecycleTransitionResponse, error) {
 	logger := klog.FromContext(ctx)
 
-	pods, err := d.listEvictablePods(ctx, targetNode)
-	if err != nil {
+	d.mu.Lock()
+	if d.abortedEvent != "" && d.abortedEvent == req.GetEventName() {
+		msg := d.abortedEventError
+		d.abortedEvent, d.abortedEventError = "", ""
+		d.mu.Unlock()
 		return &slmpbv1alpha1.LifecycleTransitionResponse{
-			NodeName: targetNode,
-			Error:    fmt.Sprintf("list pods: %v", err),
+			LifecycleCondition: DrainFailed,
+			NodeName:           targetNode,
+			Error:              msg,
+		}, nil
+	}
+	abortReason, abortCode := d.abortReason, d.abortCode
+	active := d.cancelEviction != nil && d.activeEvent == req.GetEventName()
+	var finished *drainpbv1alpha1.DrainRecord
+	if d.activeEvent != req.GetEventName() && req.GetEventName() != "" {
+		finished = d.history.find(targetNode, req.GetEventName())
+	}
+	d.mu.Unlock()
+	if finished != nil {
+		// A retried call for a drain that already ended gets the same
+		// result; resuming it would cordon and evict all over again.
+		logger.V(3).Info("Drain already finished for event, repeating its result", "event", req.GetEventName(), "node", targetNode, "condition", finished.GetCondition())
+		return &slmpbv1alpha1.LifecycleTransitionResponse{
+			LifecycleCondition: finished.GetCondition(),
+			NodeName:           targetNode,
+			Error:              finished.GetError(),
+		}, nil
+	}
+	if abortReason == "" && !active {
+		// No startDrain ran for this event in this process, most likely
+		// because the driver restarted mid-drain. Without this, pods
+		// would remain forever with nothing evicting them. A node the
+		// old process cordoned counts as cordoned before the drain, so
+		// it is not uncordoned afterwards.
+		logger.Info("No drain in progress for event, resuming it", "event", req.GetEventName(), "node", targetNode)
+		resp, err := d.startDrain(ctx, &slmpbv1alpha1.StartLifecycleTransitionRequest{
+			TransitionName: req.GetTransitionName(),
+			EventName:      req.GetEventName(),
+			NodeName:       req.GetNodeName(),
+			Start:          req.GetStart(),
+			End:            req.GetEnd(),
+		}, targetNode)
+		if err != nil || resp.GetLifecycleCondition() != req.GetStart() {
+			return resp, err
+		}
+	}
+	if abortReason != "" {
+		logger.Info("Drain aborted", "node", targetNode, "reason", abortReason)
+		msg := formatError(abortCode, "drain aborted: %s", abortReason)
+		d.finishDrain(targetNode, DrainFailed, msg)
+		return &slmpbv1alpha1.LifecycleTransitionResponse{
+			LifecycleCondition: DrainFailed,
+			NodeName:           targetNode,
+			Error:              msg,
 		}, nil
 	}
 
-	if len(pods) == 0 {
-		logger.Info("All pods evicted, drain complete", "node", targetNode)
-		d.mu.Lock()
-		d.activeEvent = ""
-		d.mu.Unlock()
+	if d.isPaused() {
+		logger.Info("Drain paused, not completing", "node", targetNode)
+		return &slmpbv1alpha1.LifecycleTransitionResponse{
+			LifecycleCondition: DrainPaused,
+			NodeName:           targetNode,
+		}, nil
+	}
+
+	pods, err := d.remainingPods(ctx, targetNode)
+	if err != nil {
+		return errorResponse(targetNode, ErrCodeListFailed, "list pods: %v", err), nil
+	}
+
+	d.mu.Lock()
+	keep := d.keepPods
+	d.mu.Unlock()
+	if len(pods) <= keep {
+		// A deleted node also has no pods; tell the two apart so
+		// controllers don't mistake it for a real drain.
+		if _, err := d.getNode(ctx, targetNode); apierrors.IsNotFound(err) {
+			logger.Info("Node deleted during drain", "node", targetNode)
+			d.finishDrain(targetNode, NodeDeleted, "")
+			return &slmpbv1alpha1.LifecycleTransitionResponse{
+				LifecycleCondition: NodeDeleted,
+				NodeName:           targetNode,
+			}, nil
+		}
+
+		// Finish first so the node watch doesn't mistake our own
+		// uncordon below for an external one.
+		if keep > 0 {
+			logger.Info("Drain fraction evicted, drain complete", "node", targetNode, "fraction", d.config.DrainFraction, "remaining", len(pods))
+		} else {
+			logger.Info("All pods evicted, drain complete", "node", targetNode)
+		}
+		cordonedByUs := d.driverCordoned()
+		record := d.finishDrain(targetNode, req.GetEnd(), "")
+		d.recordDrain(ctx, targetNode, req.GetEventName())
+		d.notifyPostDrain(ctx, record)
+
+		if d.config.UncordonAfterDrain && !cordonedByUs {
+			logger.Info("Not uncordoning node after drain, it was cordoned before the drain started", "node", targetNode)
+		} else if d.config.UncordonAfterDrain {
+			if err := d.uncordonNode(ctx, targetNode); err != nil {
+				return errorResponse(targetNode, ErrCodeUncordonFailed, "uncordon node after drain: %v", err), nil
+			}
+			logger.Info("Node uncordoned after drain", "node", targetNode)
+		}
 
 		return &slmpbv1alpha1.LifecycleTransitionResponse{
 			LifecycleCondition: req.GetEnd(),
@@ -238,6 +935,36 @@ func (d *DrainService) endDrain(ctx context.Context, req *slmpbv1alpha1.EndLifec
 		}, nil
 	}
 
+	d.observePDBBlocked(logger, targetNode, pods)
+	d.noteIgnoredTermination(ctx, targetNode)
+	d.labelBlockedPods(ctx, targetNode, pods)
+	d.renewDrainLease(ctx, targetNode)
+	d.releaseFleetBudget(ctx, targetNode)
+
+	if msg, expired := d.drainDeadlineExceeded(pods); expired {
+		logger.Info("Drain timed out", "node", targetNode, "remaining", len(pods))
+		msg = formatError(ErrCodeDrainTimeout, "%s", msg)
+		d.finishDrain(targetNode, DrainFailed, msg)
+
+		return &slmpbv1alpha1.LifecycleTransitionResponse{
+			LifecycleCondition: DrainFailed,
+			NodeName:           targetNode,
+			Error:              msg,
+		}, nil
+	}
+
+	if deadline, expired := d.eventDeadlineExceeded(); expired {
+		logger.Info("Event SLA exceeded", "node", targetNode, "deadline", deadline, "remaining", len(pods))
+		msg := formatError(ErrCodeSLAExceeded, "event deadline %s passed with %d pod(s) remaining", deadline.Format(time.RFC3339), len(pods))
+		d.finishDrain(targetNode, SLAExceeded, msg)
+
+		return &slmpbv1alpha1.LifecycleTransitionResponse{
+			LifecycleCondition: SLAExceeded,
+			NodeName:           targetNode,
+			Error:              msg,
+		}, nil
+	}
+
 	// Pods still remain — the background eviction goroutine is working
 	// on them. Report the count and return the start condition so the
 	// kubelet calls again on the next tick.
@@ -245,6 +972,10 @@ func (d *DrainService) endDrain(ctx context.Context, req *slmpbv1alpha1.EndLifec
 		"node", targetNode,
 		"remaining", len(pods),
 	)
+	if stuck := terminatingWithFinalizers(pods); len(stuck) > 0 {
+		logger.V(2).Info("Terminating pods held by finalizers", "node", targetNode, "pods", stuck)
+	}
+	d.reportDrainCondition(ctx, targetNode, DrainStarted, drainProgressMessage(len(pods)))
 
 	return &slmpbv1alpha1.LifecycleTransitionResponse{
 		LifecycleCondition: req.GetStart(),
@@ -252,22 +983,233 @@ func (d *DrainService) endDrain(ctx context.Context, req *slmpbv1alpha1.EndLifec
 	}, nil
 }
 
+// remainingPods returns the evictable pods still on the node, plus the
+// terminating pods Config.WaitForTerminating and
+// Config.WaitForDaemonSetTermination wait for.
+func (d *DrainService) remainingPods(ctx context.Context, nodeName string) ([]podInfo, error) {
+	pods, err := d.nodePods(ctx, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	skipped := make(map[string]string)
+	remaining := d.evictablePods(ctx, pods, skipped)
+	for _, pod := range pods {
+		if skipped[pod.Namespace+"/"+pod.Name] == SkipReasonPDBUnknown {
+			// Not known to be skippable, so the drain isn't done.
+			remaining = append(remaining, newPodInfo(pod))
+			continue
+		}
+		if pod.DeletionTimestamp == nil {
+			continue
+		}
+		// The driver never evicts terminating pods, but can wait for them.
+		waitFor := d.config.WaitForTerminating && skipped[pod.Namespace+"/"+pod.Name] == SkipReasonTerminating
+		if ref := metav1.GetControllerOf(pod); ref != nil && ref.Kind == "DaemonSet" && d.config.WaitForDaemonSetTermination {
+			waitFor = true
+		}
+		if waitFor {
+			remaining = append(remaining, newPodInfo(pod))
+		}
+	}
+	return remaining, nil
+}
+
+// terminatingWithFinalizers maps each terminating pod in pods that still
+// has finalizers to them, keyed by namespace/name.
+func terminatingWithFinalizers(pods []podInfo) map[string][]string {
+	stuck := make(map[string][]string)
+	for _, p := range pods {
+		if p.Terminating && len(p.Finalizers) > 0 {
+			stuck[p.key()] = p.Finalizers
+		}
+	}
+	return stuck
+}
+
+// finishDrain records the drain of nodeName in the history and clears the
+// per-event drain state once the drain reaches the terminal condition,
+// reported with errMsg. It returns the recorded drain.
+func (d *DrainService) finishDrain(nodeName, condition, errMsg string) *drainpbv1alpha1.DrainRecord {
+	d.mu.Lock()
+	record := &drainpbv1alpha1.DrainRecord{
+		NodeName:         nodeName,
+		EventName:        d.activeEvent,
+		Condition:        condition,
+		Error:            errMsg,
+		Finished:         timestamppb.Now(),
+		Evicted:          int32(d.evictedPods),
+		Failed:           int32(d.failedPods),
+		EvictionErrors:   maps.Clone(d.evictionErrors),
+		EvictionWarnings: maps.Clone(d.evictionWarnings),
+	}
+	if !d.drainStartTime.IsZero() {
+		record.Started = timestamppb.New(d.drainStartTime)
+	}
+	d.history.add(record)
+	endTransitionSpan(d.drainSpan, condition, errMsg)
+	d.drainSpan = nil
+	clear(d.acceptedEvictions)
+	clear(d.pdbBlockedSince)
+	clear(d.pdbBlockedWarned)
+	labeled := slices.Collect(maps.Keys(d.blockedLabeled))
+	clear(d.blockedLabeled)
+	d.keepPods = 0
+	metrics.LongestPDBBlockedEvictionSeconds.Set(0)
+	d.activeEvent = ""
+	d.activeNode = ""
+	d.cordonedByUs = false
+	d.overrides = nodeOverrides{}
+	d.drainStartTime = time.Time{}
+	d.abortReason = ""
+	d.abortCode = ""
+	d.eventDeadline = time.Time{}
+	if d.cancelEviction != nil {
+		d.cancelEviction()
+		d.cancelEviction = nil
+	}
+	if d.pendingReload != nil {
+		d.applyReload(*d.pendingReload)
+		d.pendingReload = nil
+	}
+	pw, nw, ew := d.podWatcher, d.nodeWatcher, d.eventWatcher
+	d.podWatcher, d.nodeWatcher, d.eventWatcher = nil, nil, nil
+	held := slices.Collect(maps.Values(d.fleetHeld))
+	clear(d.fleetHeld)
+	d.mu.Unlock()
+
+	if d.fleetBudget != nil && len(held) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), fleetBudgetReleaseTimeout)
+		if err := d.fleetBudget.release(ctx, held); err != nil {
+			klog.Background().Error(err, "Failed to release fleet disruption budget, it expires on its own", "pods", len(held))
+		}
+		cancel()
+	}
+	if d.config.DrainLease {
+		d.deleteDrainLease(nodeName)
+	}
+	if len(labeled) > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), blockedLabelRemoveTimeout)
+		d.unlabelBlockedPods(ctx, nodeName, labeled)
+		cancel()
+	}
+
+	// Stop the watches outside d.mu: stopping waits for in-flight event
+	// handlers, which may themselves be waiting on d.mu.
+	if pw != nil {
+		pw.stop()
+	}
+	if nw != nil {
+		nw.stop()
+	}
+	if ew != nil {
+		ew.stop()
+	}
+	return record
+}
+
+// handleExternalUncordon is called by the node watch when the node under
+// drain becomes schedulable again without the driver's involvement.
+func (d *DrainService) handleExternalUncordon(nodeName string) {
+	logger := klog.Background()
+
+	d.mu.Lock()
+	active := d.activeEvent
+	d.mu.Unlock()
+	if active == "" {
+		return
+	}
+
+	logger.Info("WARNING: node was uncordoned externally during drain",
+		"node", nodeName,
+		"event", active,
+		"action", d.config.ExternalUncordonAction,
+	)
+
+	switch d.config.ExternalUncordonAction {
+	case ExternalUncordonRecordon:
+		if _, err := d.cordonNode(context.Background(), nodeName); err != nil {
+			logger.Error(err, "Failed to re-cordon node", "node", nodeName)
+			return
+		}
+		logger.Info("Node re-cordoned", "node", nodeName)
+	case ExternalUncordonAbort:
+		d.abort(ErrCodeDrainAborted, "node was uncordoned externally")
+	}
+}
+
+// abort stops the background eviction and makes the next endDrain report
+// DrainFailed with code and reason.
+func (d *DrainService) abort(code ErrorCode, reason string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.abortReason = reason
+	d.abortCode = code
+	if d.cancelEviction != nil {
+		d.cancelEviction()
+	}
+}
+
+// drainDeadlineExceeded reports whether the drain timeout has elapsed since
+// startDrain. If so, it also returns a message naming the remaining pods and
+// the last eviction error recorded for each.
+func (d *DrainService) drainDeadlineExceeded(remaining []podInfo) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	timeout := d.drainTimeout()
+	if timeout <= 0 || d.drainStartTime.IsZero() {
+		return "", false
+	}
+	if time.Since(d.drainStartTime) < timeout {
+		return "", false
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "drain did not complete within %s, %d pod(s) remain:", timeout, len(remaining))
+	for i, p := range remaining {
+		if i == maxReportedPods {
+			fmt.Fprintf(&b, " ... and %d more", len(remaining)-maxReportedPods)
+			break
+		}
+		key := p.Namespace + "/" + p.Name
+		if reason, ok := d.evictionErrors[key]; ok {
+			fmt.Fprintf(&b, " %s (%s);", key, reason)
+		} else if p.Terminating && len(p.Finalizers) > 0 {
+			fmt.Fprintf(&b, " %s (terminating, finalizers: %s);", key, strings.Join(p.Finalizers, ", "))
+		} else if p.Terminating {
+			fmt.Fprintf(&b, " %s (terminating);", key)
+		} else {
+			fmt.Fprintf(&b, " %s;", key)
+		}
+	}
+	return strings.TrimSuffix(b.String(), ";"), true
+}
+
 // endUncordon verifies the node is schedulable and returns
 // maintenance-complete. If the node is still unschedulable (e.g. the
 // uncordon was interrupted), it retries and returns uncordoning.
 func (d *DrainService) endUncordon(ctx context.Context, req *slmpbv1alpha1.EndLifecycleTransitionRequest, targetNode string) (*slmpbv1alpha1.LifecycleTransitionResponse, error) {
 	logger := klog.FromContext(ctx)
 
-	node, err := d.kubeClient.CoreV1().Nodes().Get(ctx, targetNode, metav1.GetOptions{})
+	node, err := d.getNode(ctx, targetNode)
 	if err != nil {
-		return &slmpbv1alpha1.LifecycleTransitionResponse{
-			NodeName: targetNode,
-			Error:    fmt.Sprintf("get node: %v", err),
-		}, nil
+		return errorResponse(targetNode, ErrCodeGetNodeFailed, "get node: %v", err), nil
 	}
 
 	if !node.Spec.Unschedulable {
 		logger.Info("Node is schedulable, maintenance complete", "node", targetNode)
+		d.mu.Lock()
+		endTransitionSpan(d.uncordonSpan, req.GetEnd(), "")
+		d.uncordonSpan = nil
+		d.mu.Unlock()
+		if d.config.PostDrainWebhookMaintenanceComplete {
+			d.notifyPostDrain(ctx, &drainpbv1alpha1.DrainRecord{
+				NodeName:  targetNode,
+				EventName: req.GetEventName(),
+				Condition: req.GetEnd(),
+				Finished:  timestamppb.Now(),
+			})
+		}
 		return &slmpbv1alpha1.LifecycleTransitionResponse{
 			LifecycleCondition: req.GetEnd(),
 			NodeName:           targetNode,
@@ -277,10 +1219,7 @@ func (d *DrainService) endUncordon(ctx context.Context, req *slmpbv1alpha1.EndLi
 	// Node is still unschedulable — retry uncordon.
 	logger.Info("Node still unschedulable, retrying uncordon", "node", targetNode)
 	if err := d.uncordonNode(ctx, targetNode); err != nil {
-		return &slmpbv1alpha1.LifecycleTransitionResponse{
-			NodeName: targetNode,
-			Error:    fmt.Sprintf("uncordon node: %v", err),
-		}, nil
+		return errorResponse(targetNode, ErrCodeUncordonFailed, "uncordon node: %v", err), nil
 	}
 
 	return &slmpbv1alpha1.LifecycleTransitionResponse{
@@ -289,142 +1228,1011 @@ func (d *DrainService) endUncordon(ctx context.Context, req *slmpbv1alpha1.EndLi
 	}, nil
 }
 
-// cordonNode sets spec.unschedulable = true on the target node.
-func (d *DrainService) cordonNode(ctx context.Context, nodeName string) error {
-	node, err := d.kubeClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+// cordonNode sets spec.unschedulable = true on the target node, and with
+// EvictionModeTaint adds the maintenance taint. It reports whether it
+// cordoned the node, i.e. false if the node was already cordoned.
+func (d *DrainService) cordonNode(ctx context.Context, nodeName string) (bool, error) {
+	defer d.lockNode(nodeName)()
+	var cordoned bool
+	err := retry.RetryOnConflict(d.nodeUpdateBackoff(), func() error {
+		node, err := d.getNode(ctx, nodeName)
+		if err != nil {
+			return err
+		}
+		cordoned = !node.Spec.Unschedulable
+		tainted := d.config.EvictionMode == EvictionModeTaint && addMaintenanceTaint(node)
+		if !cordoned && !tainted {
+			return nil // already cordoned
+		}
+		node.Spec.Unschedulable = true
+		return d.writeNode(ctx, node)
+	})
 	if err != nil {
-		return err
-	}
-	if node.Spec.Unschedulable {
-		return nil // already cordoned
+		return false, err
 	}
-	node.Spec.Unschedulable = true
-	_, err = d.kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
-	return err
+	return cordoned, nil
+}
+
+// driverCordoned reports whether the active drain's startDrain cordoned
+// the node itself. Only then may the driver undo the cordon.
+func (d *DrainService) driverCordoned() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.cordonedByUs
 }
 
 // uncordonNode sets spec.unschedulable = false on the target node.
 func (d *DrainService) uncordonNode(ctx context.Context, nodeName string) error {
-	node, err := d.kubeClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	defer d.lockNode(nodeName)()
+	return retry.RetryOnConflict(d.nodeUpdateBackoff(), func() error {
+		node, err := d.getNode(ctx, nodeName)
+		if err != nil {
+			return err
+		}
+		untainted := removeMaintenanceTaint(node)
+		if !node.Spec.Unschedulable && !untainted {
+			return nil // already schedulable
+		}
+		node.Spec.Unschedulable = false
+		return d.writeNode(ctx, node)
+	})
+}
+
+// nodeUpdateBackoff returns the backoff for retrying a conflicting node
+// update, Config.NodeUpdateAttempts attempts in all. Jitter keeps drivers
+// and other writers of a busy node from retrying in lockstep.
+func (d *DrainService) nodeUpdateBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: 100 * time.Millisecond,
+		Factor:   2,
+		Jitter:   0.5,
+		Steps:    max(d.config.NodeUpdateAttempts, 1),
+		Cap:      5 * time.Second,
+	}
+}
+
+// lockNode serializes spec changes to nodeName within the process, so a
+// drain and an uncordon of the same node can't interleave their
+// get-and-update. It returns the unlock function.
+func (d *DrainService) lockNode(nodeName string) func() {
+	d.nodeLocksMu.Lock()
+	l, ok := d.nodeLocks[nodeName]
+	if !ok {
+		l = &sync.Mutex{}
+		d.nodeLocks[nodeName] = l
+	}
+	d.nodeLocksMu.Unlock()
+	l.Lock()
+	return l.Unlock
+}
+
+// drainDisabled returns the error to fail a drain of nodeName with if the
+// node carries Config.DrainDisabledAnnotation="true", or "". If the node
+// can't be read, the cordon that follows reports the error.
+func (d *DrainService) drainDisabled(ctx context.Context, nodeName string) string {
+	key := d.config.DrainDisabledAnnotation
+	if key == "" {
+		return ""
+	}
+	node, err := d.getNode(ctx, nodeName)
+	if err != nil || node.Annotations[key] != "true" {
+		return ""
+	}
+	return formatError(ErrCodeDrainDisabled, "node %s is annotated %s=true, remove the annotation to allow draining it", nodeName, key)
+}
+
+// getNode fetches the node, bounded by the node operation timeout.
+func (d *DrainService) getNode(ctx context.Context, nodeName string) (*corev1.Node, error) {
+	ctx, cancel := d.nodeOpContext(ctx)
+	defer cancel()
+	return d.kubeClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+}
+
+// updateNode writes the node, bounded by the node operation timeout.
+func (d *DrainService) updateNode(ctx context.Context, node *corev1.Node) error {
+	ctx, cancel := d.nodeOpContext(ctx)
+	defer cancel()
+	_, err := d.kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{FieldManager: FieldManager})
+	return err
+}
+
+// writeNode writes node's unschedulable flag and taints with
+// Config.NodeMutationMethod. A patch carries the resourceVersion the node
+// was read at, so like an update it fails with a conflict if the node
+// changed since.
+func (d *DrainService) writeNode(ctx context.Context, node *corev1.Node) error {
+	if d.config.NodeMutationMethod == NodeMutationUpdate {
+		return d.updateNode(ctx, node)
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"resourceVersion": node.ResourceVersion},
+		"spec": map[string]any{
+			"unschedulable": node.Spec.Unschedulable,
+			"taints":        node.Spec.Taints,
+		},
+	})
 	if err != nil {
 		return err
 	}
-	if !node.Spec.Unschedulable {
-		return nil // already schedulable
-	}
-	node.Spec.Unschedulable = false
-	_, err = d.kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+	ctx, cancel := d.nodeOpContext(ctx)
+	defer cancel()
+	_, err = d.kubeClient.CoreV1().Nodes().Patch(ctx, node.Name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
 	return err
 }
 
+// applyNode server-side applies node as FieldManager, bounded by the node
+// operation timeout. Conflicts with other managers are overridden: the
+// driver only applies keys it owns.
+func (d *DrainService) applyNode(ctx context.Context, node *corev1ac.NodeApplyConfiguration) error {
+	ctx, cancel := d.nodeOpContext(ctx)
+	defer cancel()
+	_, err := d.kubeClient.CoreV1().Nodes().Apply(ctx, node, metav1.ApplyOptions{FieldManager: FieldManager, Force: true})
+	return err
+}
+
+// cordonConfirmInterval is how often confirmCordon reads the node.
+const cordonConfirmInterval = 200 * time.Millisecond
+
+// confirmCordon waits until a read of nodeName served from the API
+// server's watch cache shows it unschedulable, for at most
+// Config.CordonConfirmTimeout. On timeout it logs and returns, since the
+// cordon itself succeeded.
+func (d *DrainService) confirmCordon(ctx context.Context, nodeName string) {
+	logger := klog.FromContext(ctx)
+	start := time.Now()
+	err := wait.PollUntilContextTimeout(ctx, cordonConfirmInterval, d.config.CordonConfirmTimeout, true, func(ctx context.Context) (bool, error) {
+		opCtx, cancel := d.nodeOpContext(ctx)
+		defer cancel()
+		// ResourceVersion "0" is served from the watch cache.
+		node, err := d.kubeClient.CoreV1().Nodes().Get(opCtx, nodeName, metav1.GetOptions{ResourceVersion: "0"})
+		if err != nil {
+			return false, nil
+		}
+		return node.Spec.Unschedulable, nil
+	})
+	if err != nil {
+		logger.Info("WARNING: cordon not confirmed in the watch cache, starting the drain anyway", "node", nodeName, "timeout", d.config.CordonConfirmTimeout)
+		return
+	}
+	logger.V(3).Info("Cordon confirmed", "node", nodeName, "after", time.Since(start).Round(time.Millisecond))
+}
+
+// recordDrain annotates the node with when and by which event it was last
+// drained. It is best-effort: failures are logged, never returned.
+func (d *DrainService) recordDrain(ctx context.Context, nodeName, eventName string) {
+	node := corev1ac.Node(nodeName).WithAnnotations(map[string]string{
+		LastDrainedAtAnnotation:  time.Now().UTC().Format(time.RFC3339),
+		LastDrainEventAnnotation: eventName,
+	})
+	if err := d.applyNode(ctx, node); err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to record drain on node", "node", nodeName)
+	}
+}
+
+// nodeOpContext derives a context for a single node API call. The caller's
+// context may have no deadline (e.g. background flows), so a hung API server
+// would otherwise block the call indefinitely. With
+// Config.MaxConcurrentNodeOps it first waits for a slot, outside the
+// timeout; the returned cancel func releases it.
+func (d *DrainService) nodeOpContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	release := func() {}
+	if d.nodeOps != nil {
+		select {
+		case d.nodeOps <- struct{}{}:
+			release = func() { <-d.nodeOps }
+		case <-ctx.Done():
+			// The call fails on the done context.
+		}
+	}
+	var opCtx context.Context
+	var cancel context.CancelFunc
+	if d.config.NodeOpTimeout <= 0 {
+		opCtx, cancel = context.WithCancel(ctx)
+	} else {
+		opCtx, cancel = context.WithTimeout(ctx, d.config.NodeOpTimeout)
+	}
+	return opCtx, func() {
+		cancel()
+		release()
+	}
+}
+
 // podInfo holds the name and namespace of a pod for eviction.
 type podInfo struct {
 	Name      string
 	Namespace string
+	UID       types.UID
+	// OwnerKind, OwnerName and OwnerUID identify the pod's controller,
+	// empty if unowned.
+	OwnerKind string
+	OwnerName string
+	OwnerUID  types.UID
+	// GracePeriodAnnotation is the raw value of GracePeriodAnnotation,
+	// empty if the pod does not set it.
+	GracePeriodAnnotation string
+	// StartTime is when the kubelet started the pod, or its creation time
+	// if it has not started yet.
+	StartTime time.Time
+	// TopologySpread is set if the pod has TopologySpreadConstraints.
+	TopologySpread bool
+	// Requests holds the pod's CPU and memory requests, see podRequests.
+	Requests corev1.ResourceList
+	// Tolerations are the pod's tolerations.
+	Tolerations []corev1.Toleration
+	// PreStopGracePeriod is the pod's own termination grace period if a
+	// container has a PreStop hook, nil otherwise.
+	PreStopGracePeriod *int64
+	// Terminating is set if the pod is being deleted.
+	Terminating bool
+	// Finalizers are the pod's finalizers, which keep a terminating pod
+	// around until removed.
+	Finalizers []string
+}
+
+// key returns the pod's namespace/name.
+func (p podInfo) key() string {
+	return p.Namespace + "/" + p.Name
+}
+
+// owner returns the pod's controller as kind/name, empty if unowned.
+func (p podInfo) owner() string {
+	if p.OwnerKind == "" {
+		return ""
+	}
+	return p.OwnerKind + "/" + p.OwnerName
 }
 
 // listEvictablePods returns all pods on the node that should be evicted.
 // It excludes mirror pods (owned by the kubelet) and DaemonSet pods.
 func (d *DrainService) listEvictablePods(ctx context.Context, nodeName string) ([]podInfo, error) {
-	podList, err := d.kubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
-		FieldSelector: fields.SelectorFromSet(fields.Set{
-			"spec.nodeName": nodeName,
-		}).String(),
+	pods, err := d.listNodePods(ctx, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	return d.evictablePods(ctx, pods, nil), nil
+}
+
+// listEvictablePodsWithSkips is listEvictablePods that also reports why
+// each other pod on the node is skipped, keyed by namespace/name.
+func (d *DrainService) listEvictablePodsWithSkips(ctx context.Context, nodeName string) ([]podInfo, map[string]string, error) {
+	pods, err := d.listNodePods(ctx, nodeName)
+	if err != nil {
+		return nil, nil, err
+	}
+	skipped := make(map[string]string)
+	return d.evictablePods(ctx, pods, skipped), skipped, nil
+}
+
+// nodePods returns all pods on nodeName, terminating ones included. It
+// reads from the pod watch cache when one is running and synced, and falls
+// back to listing from the API server otherwise.
+func (d *DrainService) nodePods(ctx context.Context, nodeName string) ([]*corev1.Pod, error) {
+	d.mu.Lock()
+	w := d.podWatcher
+	d.mu.Unlock()
+	if w != nil && w.hasSynced() {
+		return w.pods(), nil
+	}
+	return d.listNodePods(ctx, nodeName)
+}
+
+// listNodePods returns every pod bound to the node.
+func (d *DrainService) listNodePods(ctx context.Context, nodeName string) ([]*corev1.Pod, error) {
+	var podList *corev1.PodList
+	err := retry.OnError(d.podListBackoff(), func(err error) bool {
+		if !isTransientAPIError(err) || ctx.Err() != nil {
+			return false
+		}
+		klog.FromContext(ctx).V(3).Info("Listing pods failed, retrying", "node", nodeName, "err", err)
+		return true
+	}, func() error {
+		var err error
+		podList, err = d.kubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+			FieldSelector: d.podFieldSelector(nodeName),
+		})
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	var evictable []podInfo
-	for _, pod := range podList.Items {
-		// Skip mirror pods (static pods managed by the kubelet).
-		if _, isMirror := pod.Annotations["kubernetes.io/config.mirror"]; isMirror {
-			continue
+	pods := make([]*corev1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pods = append(pods, &podList.Items[i])
+	}
+	return pods, nil
+}
+
+// podListBackoff returns the backoff for retrying a pod list that failed
+// with a transient error, Config.PodListAttempts attempts in all. It is
+// kept short: the caller reports the failure and tries again later.
+func (d *DrainService) podListBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: 200 * time.Millisecond,
+		Factor:   2,
+		Jitter:   0.2,
+		Steps:    max(d.config.PodListAttempts, 1),
+	}
+}
+
+// isTransientAPIError reports whether err is likely to go away on retry,
+// such as a timeout, throttling or an unavailable API server. Errors such
+// as Forbidden are not.
+func isTransientAPIError(err error) bool {
+	return apierrors.IsTimeout(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsInternalError(err) ||
+		utilnet.IsConnectionRefused(err) ||
+		utilnet.IsConnectionReset(err) ||
+		utilnet.IsProbableEOF(err)
+}
+
+// Reasons a pod on the node is not evicted.
+const (
+	SkipReasonMirrorPod         = "mirror-pod"
+	SkipReasonDaemonSetPod      = "daemonset-pod"
+	SkipReasonExcludedNamespace = "excluded-namespace"
+	SkipReasonSelectorMismatch  = "selector-mismatch"
+	SkipReasonExcludedOwner     = "excluded-owner"
+	SkipReasonTerminating       = "terminating"
+	SkipReasonCompleted         = "completed"
+	// SkipReasonUnmanaged is used with Config.UnmanagedPods for pods with
+	// no controller, or a standalone ReplicaSet as their controller.
+	SkipReasonUnmanaged = "unmanaged-pod"
+	// SkipReasonPDBUnknown is used with UnmanagedPodsIfPDB for unmanaged
+	// pods whose PodDisruptionBudgets could not be listed. Such a pod is
+	// not evicted yet, but still counts as remaining, and is checked
+	// again on the next sweep.
+	SkipReasonPDBUnknown = "pdb-unknown"
+	// SkipReasonProtected is used with Config.RespectSafeToEvict for pods
+	// annotated SafeToEvictAnnotation="false", and with
+	// Config.SkipCriticalPods for critical pods.
+	SkipReasonProtected = "protected"
+)
+
+// criticalPriorityClasses are the built-in priority classes of pods
+// skipped with Config.SkipCriticalPods.
+var criticalPriorityClasses = sets.New(
+	"system-node-critical",
+	"system-cluster-critical",
+)
+
+// ownerExcluded reports whether an owner named name matches one of
+// Config.ExcludeOwnerPatterns.
+func (d *DrainService) ownerExcluded(name string) bool {
+	for _, pattern := range d.config.ExcludeOwnerPatterns {
+		// Patterns are validated by ValidateOwnerPattern at startup.
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
 		}
+	}
+	return false
+}
 
-		// Skip DaemonSet-managed pods — they will be rescheduled to the
-		// same node immediately, so evicting them is counterproductive.
-		isDaemonSet := false
-		for _, ref := range pod.OwnerReferences {
-			if ref.Kind == "DaemonSet" {
-				isDaemonSet = true
-				break
-			}
+// ValidateOwnerPattern checks that pattern is a valid glob for
+// Config.ExcludeOwnerPatterns.
+func ValidateOwnerPattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("pattern is empty")
+	}
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+// podFieldSelector returns the field selector for the pods on nodeName,
+// including any configured extra terms.
+func (d *DrainService) podFieldSelector(nodeName string) string {
+	selector := fields.OneTermEqualSelector("spec.nodeName", nodeName)
+	if d.config.PodFieldSelector != nil && !d.config.PodFieldSelector.Empty() {
+		selector = fields.AndSelectors(selector, d.config.PodFieldSelector)
+	}
+	return selector.String()
+}
+
+// podFieldSelectorFields are the pod fields the API server accepts in a
+// field selector, minus spec.nodeName which the driver always sets itself.
+var podFieldSelectorFields = sets.New(
+	"metadata.name",
+	"metadata.namespace",
+	"spec.restartPolicy",
+	"spec.schedulerName",
+	"spec.serviceAccountName",
+	"spec.hostNetwork",
+	"status.phase",
+	"status.podIP",
+	"status.podIPs",
+	"status.nominatedNodeName",
+)
+
+// ParsePodFieldSelector parses an extra pod field selector and rejects
+// fields the API server does not support for pods.
+func ParsePodFieldSelector(s string) (fields.Selector, error) {
+	selector, err := fields.ParseSelector(s)
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range selector.Requirements() {
+		if !podFieldSelectorFields.Has(req.Field) {
+			return nil, fmt.Errorf("field %q is not supported, must be one of %s", req.Field, strings.Join(sets.List(podFieldSelectorFields), ", "))
 		}
-		if isDaemonSet {
+	}
+	return selector, nil
+}
+
+// evictablePods filters pods down to the ones that should be evicted. If
+// skipped is not nil, the reason for every other pod is recorded in it.
+func (d *DrainService) evictablePods(ctx context.Context, pods []*corev1.Pod, skipped map[string]string) []podInfo {
+	var evictable []podInfo
+	for _, pod := range pods {
+		if reason := d.skipReason(ctx, pod); reason != "" {
+			if skipped != nil {
+				skipped[pod.Namespace+"/"+pod.Name] = reason
+			}
 			continue
 		}
+		evictable = append(evictable, newPodInfo(pod))
+	}
+	return evictable
+}
 
-		// Skip pods that are already terminating.
-		if pod.DeletionTimestamp != nil {
-			continue
+// skipReason returns why pod should not be evicted, or "" if it should.
+func (d *DrainService) skipReason(ctx context.Context, pod *corev1.Pod) string {
+	// Skip mirror pods (static pods managed by the kubelet).
+	if _, isMirror := pod.Annotations["kubernetes.io/config.mirror"]; isMirror {
+		return SkipReasonMirrorPod
+	}
+
+	// Skip DaemonSet-managed pods — they will be rescheduled to the
+	// same node immediately, so evicting them is counterproductive.
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "DaemonSet" && !d.config.DrainDaemonSets {
+			return SkipReasonDaemonSetPod
 		}
+	}
 
-		// Skip pods in Succeeded or Failed phase.
-		if pod.Status.Phase == "Succeeded" || pod.Status.Phase == "Failed" {
-			continue
+	// Skip pods excluded by policy.
+	d.mu.Lock()
+	excluded, selector := d.config.ExcludedNamespaces, d.config.PodSelector
+	d.mu.Unlock()
+	if excluded.Has(pod.Namespace) {
+		return SkipReasonExcludedNamespace
+	}
+	if selector != nil && !selector.Matches(labels.Set(pod.Labels)) {
+		return SkipReasonSelectorMismatch
+	}
+	for _, ref := range pod.OwnerReferences {
+		if d.ownerExcluded(ref.Name) {
+			return SkipReasonExcludedOwner
 		}
+	}
+	if d.config.RespectSafeToEvict && pod.Annotations[SafeToEvictAnnotation] == "false" {
+		return SkipReasonProtected
+	}
+	if d.config.SkipCriticalPods && criticalPriorityClasses.Has(pod.Spec.PriorityClassName) {
+		return SkipReasonProtected
+	}
 
-		evictable = append(evictable, podInfo{
-			Name:      pod.Name,
-			Namespace: pod.Namespace,
-		})
+	// Skip pods that are already terminating.
+	if pod.DeletionTimestamp != nil {
+		return SkipReasonTerminating
+	}
+
+	// Skip pods in Succeeded or Failed phase.
+	if pod.Status.Phase == "Succeeded" || pod.Status.Phase == "Failed" {
+		return SkipReasonCompleted
+	}
+
+	// Nothing may recreate an unmanaged pod, so evicting it is only safe
+	// when the policy allows it.
+	if policy := d.config.UnmanagedPods; (policy == UnmanagedPodsSkip || policy == UnmanagedPodsIfPDB) && d.unmanaged(ctx, pod) {
+		if policy == UnmanagedPodsSkip {
+			return SkipReasonUnmanaged
+		}
+		covered, err := d.pdbCovers(ctx, pod)
+		if err != nil {
+			klog.FromContext(ctx).Error(err, "Could not list PodDisruptionBudgets, checking pod again later", "pod", klog.KObj(pod))
+			return SkipReasonPDBUnknown
+		}
+		if !covered {
+			return SkipReasonUnmanaged
+		}
 	}
-	return evictable, nil
+
+	return ""
+}
+
+// newPodInfo captures the fields of pod the eviction path needs.
+func newPodInfo(pod *corev1.Pod) podInfo {
+	info := podInfo{
+		Name:                  pod.Name,
+		Namespace:             pod.Namespace,
+		UID:                   pod.UID,
+		GracePeriodAnnotation: pod.Annotations[GracePeriodAnnotation],
+		StartTime:             pod.CreationTimestamp.Time,
+		TopologySpread:        len(pod.Spec.TopologySpreadConstraints) > 0,
+		Requests:              podRequests(pod),
+		Tolerations:           pod.Spec.Tolerations,
+		Terminating:           pod.DeletionTimestamp != nil,
+		Finalizers:            pod.Finalizers,
+	}
+	if pod.Status.StartTime != nil {
+		info.StartTime = pod.Status.StartTime.Time
+	}
+	if ref := metav1.GetControllerOf(pod); ref != nil {
+		info.OwnerKind = ref.Kind
+		info.OwnerName = ref.Name
+		info.OwnerUID = ref.UID
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.Lifecycle != nil && c.Lifecycle.PreStop != nil {
+			seconds := int64(corev1.DefaultTerminationGracePeriodSeconds)
+			if pod.Spec.TerminationGracePeriodSeconds != nil {
+				seconds = *pod.Spec.TerminationGracePeriodSeconds
+			}
+			info.PreStopGracePeriod = &seconds
+			break
+		}
+	}
+	return info
 }
 
-// evictAllPods lists evictable pods and evicts each one. It returns the
-// count of successfully evicted, failed, and total pods.
-func (d *DrainService) evictAllPods(ctx context.Context, nodeName string) (evicted, failed, total int) {
+// evictAllPods lists evictable pods and evicts each one. A replacement pod
+// can still land on the node while the cordon propagates to the scheduler,
+// so after each sweep it re-lists and evicts any pods it has not seen yet,
+// up to Config.EvictionPasses sweeps. Pods younger than Config.MinPodAge,
+// and failed pods with attempts left under Config.MaxEvictionAttempts, are
+// left for a later sweep, which does not count against the limit. Every
+// Config.EvictionBatchSize evictions, it waits Config.EvictionBatchDelay.
+// It returns a summary of the outcome across all passes.
+func (d *DrainService) evictAllPods(ctx context.Context, nodeName string) EvictionSummary {
 	logger := klog.FromContext(ctx)
+	summary := newEvictionSummary()
 
-	pods, err := d.listEvictablePods(ctx, nodeName)
-	if err != nil {
-		logger.Error(err, "Failed to list pods for eviction")
-		return 0, 0, 0
-	}
-	total = len(pods)
+	passes := max(d.config.EvictionPasses, 1)
+	maxAttempts := max(d.config.MaxEvictionAttempts, 1)
+	attempts := make(map[string]int)
+	retryAt := make(map[string]time.Time)
+	found := sets.New[string]()
+	rbacConfirmed := false // a SelfSubjectAccessReview allowed eviction
+	reported := false
+	target := 0 // pods to evict under Config.DrainFraction, 0 for all
+	inBatch := 0
+	lastEvicted := make(map[types.UID]rollingEviction)
+	for pass := 1; pass <= passes; pass++ {
+		var pods []podInfo
+		var err error
+		var skipped map[string]string
+		pods, skipped, err = d.listEvictablePodsWithSkips(ctx, nodeName)
+		if !reported {
+			// Report what the drain leaves behind once, on the first sweep.
+			if err == nil {
+				reqs := summarizeRequests(pods)
+				logger.Info("Starting eviction", "node", nodeName,
+					"evictable", len(pods),
+					"cpuRequests", reqs.CPU.String(),
+					"memoryRequests", reqs.Memory.String(),
+					"podsWithoutRequests", reqs.NoRequestPods,
+					"skipped", skipped,
+				)
+				reported = true
+				target = d.partialDrainTarget(ctx, nodeName, len(pods))
+			}
+		}
+		if err != nil {
+			logger.Error(err, "Failed to list pods for eviction", "pass", pass)
+			return summary
+		}
 
-	for _, p := range pods {
-		if err := d.evictPod(ctx, p); err != nil {
-			logger.V(3).Info("Eviction failed",
-				"pod", p.Namespace+"/"+p.Name,
-				"err", err,
+		var pending, waiting []podInfo
+		var deferFor time.Duration
+		if slices.Contains(slices.Collect(maps.Values(skipped)), SkipReasonPDBUnknown) {
+			// Sweep again once the budgets can be listed.
+			deferFor = evictionRetryInterval
+		}
+		newPods := 0
+		for _, p := range pods {
+			if n := attempts[p.key()]; n >= maxAttempts {
+				continue
+			} else if n > 0 {
+				// Still listed after a failed eviction: retry it once
+				// its retry delay has passed.
+				if wait := time.Until(retryAt[p.key()]); wait > 0 {
+					if deferFor == 0 || wait < deferFor {
+						deferFor = wait
+					}
+					waiting = append(waiting, p)
+					continue
+				}
+				pending = append(pending, p)
+				continue
+			}
+			if wait := d.untilMinPodAge(p); wait > 0 {
+				if deferFor == 0 || wait < deferFor {
+					deferFor = wait
+				}
+				continue
+			}
+			pending = append(pending, p)
+			if !found.Has(p.key()) {
+				found.Insert(p.key())
+				newPods++
+			}
+		}
+		if len(pending) == 0 && deferFor == 0 {
+			break
+		}
+		if pass > 1 && newPods > 0 {
+			logger.Info("Found new pods on node after eviction sweep", "node", nodeName, "pass", pass, "count", newPods)
+		}
+		summary.Total += newPods
+
+		// Evict pods with topology spread constraints last, so their
+		// spread is disturbed for as short a time as possible. Pods
+		// tolerating Config.EvictLastTolerating go after all of those.
+		// Config.EvictByTier orders by tier before anything else.
+		tiers := d.podTiers(ctx, pending)
+		pending = d.holdLaterTiers(ctx, pending, tiers, waiting)
+		slices.SortStableFunc(pending, func(a, b podInfo) int {
+			return cmp.Or(
+				cmp.Compare(tiers[a.key()], tiers[b.key()]),
+				cmp.Compare(b2i(d.evictLast(a)), b2i(d.evictLast(b))),
+				cmp.Compare(b2i(a.TopologySpread), b2i(b.TopologySpread)),
 			)
+		})
+		if d.config.RollingEviction {
+			// Rolling rounds must not mix tiers.
+			var ordered []podInfo
+			for _, run := range tierRuns(pending, tiers) {
+				ordered = append(ordered, rollingOrder(run)...)
+			}
+			pending = ordered
+		}
+
+		retryPending := false
+		var tier int
+		var tierEvicted []podInfo
+		if len(pending) > 0 {
+			tier = tiers[pending[0].key()]
+		}
+		for _, p := range pending {
+			if err := ctx.Err(); err != nil {
+				logger.Info("Eviction stopped", "node", nodeName, "err", err, "evicted", summary.Evicted, "failed", summary.Failed)
+				return summary
+			}
+			if target > 0 && summary.Evicted >= target {
+				logger.Info("Drain fraction evicted, leaving the remaining pods", "node", nodeName, "evicted", summary.Evicted)
+				return summary
+			}
+			if next := tiers[p.key()]; next != tier {
+				if retryPending {
+					logger.Info("Tier not fully evicted, retrying it before the next tier", "node", nodeName, "tier", tier, "next", next)
+					break
+				}
+				if err := d.waitForTierGone(ctx, nodeName, tier, tierEvicted); err != nil {
+					return summary
+				}
+				logger.Info("Evicting next tier", "node", nodeName, "tier", next)
+				tier, tierEvicted = next, nil
+			}
+			if d.config.EvictionBatchSize > 0 && inBatch == d.config.EvictionBatchSize {
+				logger.V(3).Info("Eviction batch done, waiting before the next", "node", nodeName, "delay", d.config.EvictionBatchDelay)
+				if err := sleepWithContext(ctx, d.config.EvictionBatchDelay); err != nil {
+					return summary
+				}
+				inBatch = 0
+			}
+			if err := d.waitIfPaused(ctx); err != nil {
+				logger.Info("Eviction stopped while paused", "node", nodeName, "err", err)
+				return summary
+			}
+			if prev, ok := lastEvicted[p.OwnerUID]; ok && d.config.RollingEviction {
+				if err := d.waitForReplacementReady(ctx, nodeName, prev); err != nil {
+					return summary
+				}
+				delete(lastEvicted, p.OwnerUID)
+			}
+			attempts[p.key()]++
+			inBatch++
 			d.mu.Lock()
-			d.evictionErrors[p.Namespace+"/"+p.Name] = err.Error()
+			ew := d.eventWatcher
 			d.mu.Unlock()
-			failed++
-		} else {
-			logger.V(3).Info("Pod evicted", "pod", p.Namespace+"/"+p.Name)
-			evicted++
+			if ew != nil {
+				ew.track(p)
+			}
+			if d.fleetBudget != nil {
+				// acquire retries until it gets budget, so an error
+				// means the drain is over.
+				if err := d.fleetBudget.acquire(ctx, p); err != nil {
+					logger.Info("Eviction stopped waiting for fleet disruption budget", "node", nodeName, "err", err)
+					return summary
+				}
+			}
+			err := d.evictPod(ctx, p)
+			d.traceEviction(p, attempts[p.key()], err)
+			d.holdFleetBudget(ctx, p, err == nil)
+			if apierrors.IsForbidden(err) && !rbacConfirmed {
+				// If the driver lacks RBAC, every pod would fail the
+				// same way, so don't repeat it N times. Otherwise only
+				// this pod was refused and is recorded as failed below.
+				denied, reviewErr := d.evictionDenied(ctx, p)
+				if reviewErr != nil {
+					logger.Error(reviewErr, "Could not check eviction RBAC", "node", nodeName, "pod", p.key())
+				} else if denied {
+					logger.Error(err, "Eviction forbidden by RBAC, aborting drain", "node", nodeName, "pod", p.key(), "owner", p.owner())
+					d.abort(ErrCodeEvictionForbidden, fmt.Sprintf("eviction forbidden, check RBAC for pods/eviction and pods delete: %v", err))
+					summary.recordFailure(p.key(), err.Error(), err, true)
+					return summary
+				} else {
+					rbacConfirmed = true
+				}
+			}
+			if err != nil {
+				if apierrors.IsTooManyRequests(err) {
+					d.notePDBBlocked(p)
+				}
+				msg := d.evictionErrorMessage(ctx, p, err)
+				gaveUp := attempts[p.key()] >= maxAttempts
+				if !d.config.EvictionLogSummary {
+					logger.V(3).Info("Eviction failed",
+						"pod", p.key(),
+						"owner", p.owner(),
+						"attempt", attempts[p.key()],
+						"err", err,
+					)
+				} else if gaveUp {
+					logger.Info("Eviction failed", "pod", p.key(), "owner", p.owner(), "attempts", attempts[p.key()], "err", err)
+				}
+				if gaveUp && maxAttempts > 1 {
+					msg = fmt.Sprintf("gave up after %d attempts: %s", maxAttempts, msg)
+				}
+				d.mu.Lock()
+				d.evictionErrors[p.key()] = msg
+				if gaveUp {
+					d.failedPods++
+				}
+				d.mu.Unlock()
+				summary.recordFailure(p.key(), msg, err, gaveUp)
+				if !gaveUp {
+					retryPending = true
+					delay := evictionRetryDelay(err, attempts[p.key()])
+					retryAt[p.key()] = time.Now().Add(delay)
+					if deferFor == 0 || delay < deferFor {
+						deferFor = delay
+					}
+				}
+			} else {
+				if !d.config.EvictionLogSummary {
+					logger.V(3).Info("Pod evicted", "pod", p.key(), "owner", p.owner())
+				}
+				d.mu.Lock()
+				d.evictedPods++
+				d.acceptedEvictions[p.key()] = true
+				d.mu.Unlock()
+				summary.recordEvicted(p.key())
+				if tiers != nil {
+					tierEvicted = append(tierEvicted, p)
+				}
+				d.verifyReschedule(ctx, nodeName, p)
+				if p.OwnerUID != "" {
+					lastEvicted[p.OwnerUID] = rollingEviction{pod: p, evictedAt: time.Now()}
+				}
+			}
+		}
+
+		if d.config.EvictionLogSummary {
+			logger.Info("Eviction sweep complete", "node", nodeName, "pass", pass, "evicted", summary.Evicted, "failed", summary.Failed, "total", summary.Total)
+		}
+		if deferFor > 0 {
+			logger.Info("Waiting before the next eviction sweep", "node", nodeName, "wait", deferFor, "retrying", retryPending)
+			if err := sleepWithContext(ctx, deferFor); err != nil {
+				return summary
+			}
+			pass--
 		}
 	}
-	return evicted, failed, total
+	return summary
+}
+
+// partialDrainTarget returns how many of the evictable pods found when
+// eviction started a partial drain under Config.DrainFraction evicts, or 0
+// for a full drain, and records how many may remain for endDrain.
+func (d *DrainService) partialDrainTarget(ctx context.Context, nodeName string, evictable int) int {
+	fraction := d.config.DrainFraction
+	if fraction <= 0 || fraction >= 1 {
+		return 0
+	}
+	target := int(math.Ceil(fraction * float64(evictable)))
+	d.mu.Lock()
+	d.keepPods = evictable - target
+	d.mu.Unlock()
+	klog.FromContext(ctx).Info("Partial drain", "node", nodeName, "fraction", fraction, "evicting", target, "keeping", evictable-target)
+	return target
 }
 
-// evictPod sends an Eviction for a single pod.
+// evictionRetryDelay returns how long to wait before retrying an eviction
+// that failed with err on the given attempt: the Retry-After hint in the
+// error's status details if the API server sent one, as it may when a
+// PodDisruptionBudget rejects the eviction, and otherwise
+// evictionRetryInterval doubled per attempt up to maxEvictionRetryInterval.
+func evictionRetryDelay(err error, attempt int) time.Duration {
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+		return time.Duration(seconds) * time.Second
+	}
+	return min(evictionRetryInterval<<min(max(attempt-1, 0), 8), maxEvictionRetryInterval)
+}
+
+// evictLast reports whether p tolerates taints with the key
+// Config.EvictLastTolerating, whatever their value and effect.
+func (d *DrainService) evictLast(p podInfo) bool {
+	key := d.config.EvictLastTolerating
+	if key == "" {
+		return false
+	}
+	for _, t := range p.Tolerations {
+		if t.Key == key || (t.Key == "" && t.Operator == corev1.TolerationOpExists) {
+			return true
+		}
+	}
+	return false
+}
+
+// b2i returns 1 for true and 0 for false.
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// untilMinPodAge returns how long until p is old enough to evict under
+// Config.MinPodAge, or zero if it already is.
+func (d *DrainService) untilMinPodAge(p podInfo) time.Duration {
+	if d.config.MinPodAge <= 0 || p.StartTime.IsZero() {
+		return 0
+	}
+	return max(d.config.MinPodAge-time.Since(p.StartTime), 0)
+}
+
+// evictPod removes a single pod using the strategy resolved for it. API
+// server warnings about the eviction are logged and recorded for the pod.
+// The request is conditional on the UID p was listed with, so a pod
+// recreated under the same name since then is left for the next sweep.
+// Each call is bounded by Config.EvictionTimeout.
 func (d *DrainService) evictPod(ctx context.Context, p podInfo) error {
+	ctx, warnings := withWarningCollector(ctx)
+	defer func() {
+		messages := warnings.list()
+		if len(messages) == 0 {
+			return
+		}
+		klog.FromContext(ctx).Info("API server returned warnings for eviction", "pod", p.key(), "warnings", messages)
+		d.mu.Lock()
+		d.evictionWarnings[p.key()] = strings.Join(messages, "; ")
+		d.mu.Unlock()
+	}()
+	d.mu.Lock()
+	timeout := d.evictionTimeout()
+	d.mu.Unlock()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if d.evictionStrategyFor(p) == EvictionStrategyDelete {
+		err := d.kubeClient.CoreV1().Pods(p.Namespace).Delete(ctx, p.Name, *d.deleteOptionsForPod(ctx, p))
+		if podGone(err) {
+			d.logPodGone(ctx, p)
+			return nil // pod already gone
+		}
+		return err
+	}
+
 	eviction := &policyv1.Eviction{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      p.Name,
 			Namespace: p.Namespace,
 		},
-		DeleteOptions: d.deleteOptions(),
+		DeleteOptions: d.deleteOptionsForPod(ctx, p),
 	}
 	err := d.kubeClient.CoreV1().Pods(p.Namespace).EvictV1(ctx, eviction)
-	if apierrors.IsNotFound(err) {
+	if podGone(err) {
+		d.logPodGone(ctx, p)
 		return nil // pod already gone
 	}
 	return err
 }
 
-// deleteOptions returns the metav1.DeleteOptions for evictions, honouring
-// the configured grace period.
-func (d *DrainService) deleteOptions() *metav1.DeleteOptions {
+// podGone reports whether an eviction or delete failed because the pod is
+// gone: not found, or replaced by a pod with a different UID, which fails
+// the UID precondition with a conflict.
+func podGone(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsConflict(err)
+}
+
+// logPodGone logs at V(4) whether a 404 for p was because the pod or its
+// whole namespace is gone, so namespace deletion mid-drain is visible
+// when debugging.
+func (d *DrainService) logPodGone(ctx context.Context, p podInfo) {
+	logger := klog.FromContext(ctx)
+	if !logger.V(4).Enabled() {
+		return
+	}
+	_, err := d.kubeClient.CoreV1().Namespaces().Get(ctx, p.Namespace, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		logger.V(4).Info("Pod gone before eviction, its namespace was deleted", "pod", p.key())
+	case err != nil:
+		logger.V(4).Info("Pod gone before eviction, could not check its namespace", "pod", p.key(), "err", err)
+	default:
+		logger.V(4).Info("Pod gone before eviction", "pod", p.key())
+	}
+}
+
+// evictionStrategyFor resolves the strategy for a single pod. Only pods
+// owned by a ReplicaSet are deleted directly, since their controller will
+// recreate them elsewhere; everything else goes through the Eviction API.
+func (d *DrainService) evictionStrategyFor(p podInfo) EvictionStrategy {
+	d.mu.Lock()
+	strategy := d.evictionStrategy()
+	d.mu.Unlock()
+	if strategy == EvictionStrategyDelete && p.OwnerKind == "ReplicaSet" {
+		return EvictionStrategyDelete
+	}
+	return EvictionStrategyEviction
+}
+
+// deleteOptionsForPod returns the metav1.DeleteOptions for evicting p,
+// with a precondition on its UID. The grace period comes from the pod's
+// GracePeriodAnnotation if set, then the node's override or the configured
+// grace period, and otherwise the pod's own default. With
+// Config.KeepPreStopGracePeriod, an override shorter than the own grace
+// period of a pod with a PreStop hook is ignored.
+func (d *DrainService) deleteOptionsForPod(ctx context.Context, p podInfo) *metav1.DeleteOptions {
 	opts := &metav1.DeleteOptions{}
-	if d.gracePeriod >= 0 {
-		opts.GracePeriodSeconds = &d.gracePeriod
+	if p.UID != "" {
+		opts.Preconditions = metav1.NewUIDPreconditions(string(p.UID))
+	}
+	if p.GracePeriodAnnotation != "" {
+		seconds, err := strconv.ParseInt(p.GracePeriodAnnotation, 10, 64)
+		if err == nil && seconds >= 0 {
+			opts.GracePeriodSeconds = &seconds
+			return opts
+		}
+		klog.FromContext(ctx).Info("Ignoring invalid grace period annotation",
+			"pod", p.Namespace+"/"+p.Name,
+			"annotation", GracePeriodAnnotation,
+			"value", p.GracePeriodAnnotation,
+		)
+	}
+	d.mu.Lock()
+	gracePeriod := d.gracePeriod()
+	d.mu.Unlock()
+	if gracePeriod >= 0 && d.config.KeepPreStopGracePeriod && p.PreStopGracePeriod != nil && gracePeriod < *p.PreStopGracePeriod {
+		klog.FromContext(ctx).V(2).Info("Keeping the grace period of a pod with a PreStop hook",
+			"pod", p.key(),
+			"gracePeriod", *p.PreStopGracePeriod,
+			"override", gracePeriod,
+		)
+		return opts
+	}
+	if gracePeriod >= 0 {
+		opts.GracePeriodSeconds = &gracePeriod
 	}
 	return opts
 }
+
+// sleepWithContext waits for delay or until ctx is done, whichever comes
+// first. It returns ctx.Err() if the context ended the wait.
+func sleepWithContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
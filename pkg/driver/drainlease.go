@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1ac "k8s.io/client-go/applyconfigurations/coordination/v1"
+	"k8s.io/klog/v2"
+)
+
+// drainLeaseDeleteTimeout bounds deleting the drain Lease once the drain
+// finishes.
+const drainLeaseDeleteTimeout = 10 * time.Second
+
+// DrainLeaseName returns the name of the Lease Config.DrainLease keeps for
+// drains of nodeName.
+func DrainLeaseName(nodeName string) string {
+	return "kssd-drain-" + nodeName
+}
+
+// applyDrainLease creates or renews the Lease of the active drain of
+// nodeName. Its holder is the event being drained, its acquire time when
+// the drain started, and its renew time the last time the drain made
+// progress. It reports whether the Lease was written; failures are
+// logged.
+func (d *DrainService) applyDrainLease(ctx context.Context, nodeName string) bool {
+	d.mu.Lock()
+	event, started := d.activeEvent, d.drainStartTime
+	d.mu.Unlock()
+	if started.IsZero() {
+		return false
+	}
+
+	ns := d.config.DrainLeaseNamespace
+	lease := coordinationv1ac.Lease(DrainLeaseName(nodeName), ns).
+		WithSpec(coordinationv1ac.LeaseSpec().
+			WithHolderIdentity(event).
+			WithAcquireTime(metav1.NewMicroTime(started)).
+			WithRenewTime(metav1.NowMicro()))
+	if _, err := d.kubeClient.CoordinationV1().Leases(ns).Apply(ctx, lease, metav1.ApplyOptions{FieldManager: FieldManager, Force: true}); err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to renew drain Lease", "node", nodeName, "lease", klog.KRef(ns, DrainLeaseName(nodeName)))
+		return false
+	}
+	return true
+}
+
+// renewDrainLease renews the drain Lease if pods were evicted since it was
+// last renewed, so a Lease that goes stale marks a drain not progressing.
+func (d *DrainService) renewDrainLease(ctx context.Context, nodeName string) {
+	if !d.config.DrainLease {
+		return
+	}
+	d.mu.Lock()
+	evicted := d.evictedPods
+	progressed := evicted > d.leaseEvicted
+	d.mu.Unlock()
+	if !progressed || !d.applyDrainLease(ctx, nodeName) {
+		return
+	}
+	d.mu.Lock()
+	d.leaseEvicted = evicted
+	d.mu.Unlock()
+}
+
+// deleteDrainLease deletes the drain Lease of nodeName, if any.
+func (d *DrainService) deleteDrainLease(nodeName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), drainLeaseDeleteTimeout)
+	defer cancel()
+	ns := d.config.DrainLeaseNamespace
+	err := d.kubeClient.CoordinationV1().Leases(ns).Delete(ctx, DrainLeaseName(nodeName), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		klog.Background().Error(err, "Failed to delete drain Lease", "node", nodeName, "lease", klog.KRef(ns, DrainLeaseName(nodeName)))
+	}
+}
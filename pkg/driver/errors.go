@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"fmt"
+
+	slmpbv1alpha1 "k8s.io/kubelet/pkg/apis/slm/v1alpha1"
+)
+
+// ErrorCode is a stable prefix on LifecycleTransitionResponse.Error that
+// lets controllers branch on the failure category without parsing the
+// human-readable message that follows it.
+type ErrorCode string
+
+const (
+	ErrCodeCordonFailed      ErrorCode = "CORDON_FAILED"
+	ErrCodeUncordonFailed    ErrorCode = "UNCORDON_FAILED"
+	ErrCodeListFailed        ErrorCode = "LIST_FAILED"
+	ErrCodeGetNodeFailed     ErrorCode = "GET_NODE_FAILED"
+	ErrCodeDrainTimeout      ErrorCode = "DRAIN_TIMEOUT"
+	ErrCodeDrainAborted      ErrorCode = "DRAIN_ABORTED"
+	ErrCodeSLAExceeded       ErrorCode = "SLA_EXCEEDED"
+	ErrCodeEvictionForbidden ErrorCode = "EVICTION_FORBIDDEN"
+	ErrCodeCordonForbidden   ErrorCode = "CORDON_FORBIDDEN"
+	ErrCodeDrainDisabled     ErrorCode = "DRAIN_DISABLED"
+)
+
+// formatError renders an error message as "<CODE>: <message>".
+func formatError(code ErrorCode, format string, args ...any) string {
+	return string(code) + ": " + fmt.Sprintf(format, args...)
+}
+
+// errorResponse builds a response that carries only an error for nodeName.
+func errorResponse(nodeName string, code ErrorCode, format string, args ...any) *slmpbv1alpha1.LifecycleTransitionResponse {
+	return &slmpbv1alpha1.LifecycleTransitionResponse{
+		NodeName: nodeName,
+		Error:    formatError(code, format, args...),
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// eventWatcher logs cluster Events about the pods a drain evicts, and
+// FailedScheduling events in their namespaces (usually their
+// replacements), so cluster reactions show up next to the driver's own
+// log lines. It watches only the namespaces of the pods it tracks, each
+// from the first pod tracked in it until stop.
+type eventWatcher struct {
+	kubeClient kubernetes.Interface
+	stopCh     chan struct{}
+	nodeName   string
+	since      time.Time
+
+	mu        sync.Mutex
+	stopped   bool
+	pods      sets.Set[string] // podInfo keys being evicted
+	factories map[string]informers.SharedInformerFactory
+}
+
+// newEventWatcher creates a watcher for pod Events during a drain of
+// nodeName. It watches nothing until track is called.
+func newEventWatcher(kubeClient kubernetes.Interface, nodeName string) *eventWatcher {
+	return &eventWatcher{
+		kubeClient: kubeClient,
+		stopCh:     make(chan struct{}),
+		nodeName:   nodeName,
+		since:      time.Now(),
+		pods:       sets.New[string](),
+		factories:  make(map[string]informers.SharedInformerFactory),
+	}
+}
+
+// track adds p to the pods whose Events are logged, and starts watching
+// p's namespace if no earlier pod was in it.
+func (w *eventWatcher) track(p podInfo) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pods.Insert(p.key())
+	if w.stopped || w.factories[p.Namespace] != nil {
+		return
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(w.kubeClient, 0,
+		informers.WithNamespace(p.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("involvedObject.kind", "Pod").String()
+		}),
+	)
+	_, err := factory.Core().V1().Events().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    w.handle,
+		UpdateFunc: func(_, newObj any) { w.handle(newObj) },
+	})
+	if err != nil {
+		klog.Background().Error(err, "Failed to add pod event handler", "node", w.nodeName, "namespace", p.Namespace)
+	}
+	w.factories[p.Namespace] = factory
+	factory.Start(w.stopCh)
+}
+
+// handle logs obj if it is a recent Event about a tracked pod, or a
+// FailedScheduling Event in a tracked namespace.
+func (w *eventWatcher) handle(obj any) {
+	ev, ok := obj.(*corev1.Event)
+	if !ok || eventTime(ev).Before(w.since) {
+		return
+	}
+	key := ev.InvolvedObject.Namespace + "/" + ev.InvolvedObject.Name
+
+	w.mu.Lock()
+	relevant := w.pods.Has(key) || ev.Reason == "FailedScheduling"
+	w.mu.Unlock()
+	if !relevant {
+		return
+	}
+
+	klog.Background().Info("Pod event during drain",
+		"node", w.nodeName,
+		"pod", key,
+		"type", ev.Type,
+		"reason", ev.Reason,
+		"message", ev.Message,
+		"count", ev.Count,
+	)
+}
+
+// eventTime returns the most recent time recorded on ev.
+func eventTime(ev *corev1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
+
+// stop ends the watches and waits for the informer goroutines to exit.
+func (w *eventWatcher) stop() {
+	w.mu.Lock()
+	w.stopped = true
+	factories := w.factories
+	w.mu.Unlock()
+
+	close(w.stopCh)
+	for _, factory := range factories {
+		factory.Shutdown()
+	}
+}
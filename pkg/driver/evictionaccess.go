@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// evictionDenied asks the API server, with a SelfSubjectAccessReview,
+// whether the driver's RBAC denies the call evictPod makes for p. A
+// Forbidden eviction alone doesn't tell: an admission webhook or a
+// namespace policy may reject just that pod.
+func (d *DrainService) evictionDenied(ctx context.Context, p podInfo) (bool, error) {
+	attrs := &authorizationv1.ResourceAttributes{
+		Namespace:   p.Namespace,
+		Verb:        "create",
+		Resource:    "pods",
+		Subresource: "eviction",
+	}
+	if d.evictionStrategyFor(p) == EvictionStrategyDelete {
+		attrs = &authorizationv1.ResourceAttributes{
+			Namespace: p.Namespace,
+			Verb:      "delete",
+			Resource:  "pods",
+		}
+	}
+	review, err := d.kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attrs},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return !review.Status.Allowed, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// EvictionSummary is the outcome of the background eviction of a node.
+type EvictionSummary struct {
+	// Total counts the pods the eviction found to evict, across sweeps.
+	Total int
+	// Evicted counts the pods evicted or deleted.
+	Evicted int
+	// Failed counts the pods the eviction gave up on. PDBBlocked,
+	// Forbidden and TimedOut break it down by the last error; pods that
+	// failed for other reasons are only in Failed.
+	Failed     int
+	PDBBlocked int
+	Forbidden  int
+	TimedOut   int
+	// Errors holds the last eviction error of each pod that was not
+	// evicted, keyed by namespace/name, including pods still being
+	// retried when the eviction stopped.
+	Errors map[string]string
+}
+
+// newEvictionSummary returns an empty summary.
+func newEvictionSummary() EvictionSummary {
+	return EvictionSummary{Errors: make(map[string]string)}
+}
+
+// recordEvicted records that the pod with key was evicted.
+func (s *EvictionSummary) recordEvicted(key string) {
+	s.Evicted++
+	delete(s.Errors, key)
+}
+
+// recordFailure records msg as the pod's last eviction error and, if gaveUp,
+// counts the pod as failed under the category of err.
+func (s *EvictionSummary) recordFailure(key, msg string, err error, gaveUp bool) {
+	s.Errors[key] = msg
+	if !gaveUp {
+		return
+	}
+	s.Failed++
+	switch {
+	case apierrors.IsTooManyRequests(err):
+		s.PDBBlocked++
+	case apierrors.IsForbidden(err):
+		s.Forbidden++
+	case errors.Is(err, context.DeadlineExceeded) || apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err):
+		s.TimedOut++
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// fleetBudgetPollInterval is how often a driver waiting for fleet
+	// budget checks again.
+	fleetBudgetPollInterval = 5 * time.Second
+	// fleetBudgetReleaseTimeout bounds releasing budget when a drain
+	// finishes.
+	fleetBudgetReleaseTimeout = 10 * time.Second
+)
+
+// fleetBudgetBackoff spaces out acquire's retries after a failed update of
+// the budget ConfigMap, usually a conflict with another driver. The jitter
+// keeps drivers that conflicted from retrying in lockstep.
+var fleetBudgetBackoff = wait.Backoff{
+	Duration: 200 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.5,
+	Steps:    5,
+	Cap:      fleetBudgetPollInterval,
+}
+
+// fleetBudget caps how many pods all drivers in the cluster have disrupted
+// at once. The drivers coordinate through one shared ConfigMap. Each
+// data entry is one disrupted pod:
+//
+//	data:
+//	  "<node>.<namespace>.<pod>": "<RFC 3339 expiry>"
+//
+// A driver adds an entry before evicting a pod and removes it once the pod
+// has left the node. Entries past their expiry are ignored and pruned, so
+// a driver that dies mid-drain can't hold budget forever. Writes use the
+// ConfigMap's resourceVersion, so concurrent drivers never overcommit.
+type fleetBudget struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	name       string
+	max        int
+	ttl        time.Duration
+	nodeName   string
+}
+
+// newFleetBudget returns the budget described by config for drains of
+// nodeName, or nil if Config.MaxFleetDisruptions is not set.
+func newFleetBudget(kubeClient kubernetes.Interface, nodeName string, config Config) (*fleetBudget, error) {
+	if config.MaxFleetDisruptions <= 0 {
+		return nil, nil
+	}
+	namespace, name, ok := strings.Cut(config.FleetBudgetConfigMap, "/")
+	if !ok || namespace == "" || name == "" {
+		return nil, fmt.Errorf("fleet budget ConfigMap %q must be <namespace>/<name>", config.FleetBudgetConfigMap)
+	}
+	return &fleetBudget{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		name:       name,
+		max:        config.MaxFleetDisruptions,
+		ttl:        config.FleetDisruptionTTL,
+		nodeName:   nodeName,
+	}, nil
+}
+
+// entryKey returns the ConfigMap data key for p.
+func (b *fleetBudget) entryKey(p podInfo) string {
+	return b.nodeName + "." + p.Namespace + "." + p.Name
+}
+
+// acquire blocks until it has recorded p as disrupted without exceeding
+// the fleet-wide cap. Conflicts and other API errors are retried with
+// fleetBudgetBackoff, so it returns an error only once ctx ends.
+func (b *fleetBudget) acquire(ctx context.Context, p podInfo) error {
+	logger := klog.FromContext(ctx)
+	key := b.entryKey(p)
+	backoff := fleetBudgetBackoff
+	for {
+		acquired, err := b.tryAcquire(ctx, key)
+		var delay time.Duration
+		switch {
+		case err != nil:
+			delay = backoff.Step()
+			logger.V(3).Info("Could not update fleet disruption budget, retrying", "pod", p.key(), "wait", delay, "err", err)
+		case acquired:
+			return nil
+		default:
+			backoff = fleetBudgetBackoff
+			delay = fleetBudgetPollInterval
+			logger.V(3).Info("Fleet disruption budget exhausted, waiting", "pod", p.key(), "max", b.max)
+		}
+		if err := sleepWithContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// tryAcquire makes one attempt to record key in the budget ConfigMap. It
+// reports false if the cap is reached.
+func (b *fleetBudget) tryAcquire(ctx context.Context, key string) (bool, error) {
+	cm, err := b.get(ctx)
+	if err != nil {
+		return false, err
+	}
+	b.prune(cm, time.Now())
+	if _, held := cm.Data[key]; !held && len(cm.Data) >= b.max {
+		return false, nil
+	}
+	cm.Data[key] = time.Now().Add(b.ttl).UTC().Format(time.RFC3339)
+	if _, err := b.kubeClient.CoreV1().ConfigMaps(b.namespace).Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// release removes the entries for pods. Releasing an entry that is not
+// held is a no-op.
+func (b *fleetBudget) release(ctx context.Context, pods []podInfo) error {
+	if len(pods) == 0 {
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := b.get(ctx)
+		if err != nil {
+			return err
+		}
+		changed := b.prune(cm, time.Now())
+		for _, p := range pods {
+			if _, ok := cm.Data[b.entryKey(p)]; ok {
+				delete(cm.Data, b.entryKey(p))
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+		_, err = b.kubeClient.CoreV1().ConfigMaps(b.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// get returns the budget ConfigMap, creating it empty if it is missing.
+func (b *fleetBudget) get(ctx context.Context) (*corev1.ConfigMap, error) {
+	cms := b.kubeClient.CoreV1().ConfigMaps(b.namespace)
+	cm, err := cms.Get(ctx, b.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm, err = cms.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: b.namespace, Name: b.name},
+		}, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			cm, err = cms.Get(ctx, b.name, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	return cm, nil
+}
+
+// prune drops expired or unparsable entries from cm and reports whether
+// it removed any.
+func (b *fleetBudget) prune(cm *corev1.ConfigMap, now time.Time) bool {
+	pruned := false
+	for key, value := range cm.Data {
+		expiry, err := time.Parse(time.RFC3339, value)
+		if err != nil || now.After(expiry) {
+			delete(cm.Data, key)
+			pruned = true
+		}
+	}
+	return pruned
+}
+
+// holdFleetBudget keeps the budget acquired for p while the evicted pod
+// leaves the node, or releases it right away if the eviction failed.
+func (d *DrainService) holdFleetBudget(ctx context.Context, p podInfo, evicted bool) {
+	if d.fleetBudget == nil {
+		return
+	}
+	if evicted {
+		d.mu.Lock()
+		d.fleetHeld[p.key()] = p
+		d.mu.Unlock()
+		return
+	}
+	if err := d.fleetBudget.release(ctx, []podInfo{p}); err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to release fleet disruption budget, it expires on its own", "pod", p.key())
+	}
+}
+
+// releaseFleetBudget releases the budget held by evicted pods that have
+// left nodeName. A pod still terminating keeps its budget until it is
+// gone.
+func (d *DrainService) releaseFleetBudget(ctx context.Context, nodeName string) {
+	if d.fleetBudget == nil {
+		return
+	}
+	d.mu.Lock()
+	held := len(d.fleetHeld)
+	d.mu.Unlock()
+	if held == 0 {
+		return
+	}
+	pods, err := d.nodePods(ctx, nodeName)
+	if err != nil {
+		klog.FromContext(ctx).V(3).Info("Listing pods failed, releasing fleet disruption budget later", "node", nodeName, "err", err)
+		return
+	}
+	onNode := sets.New[types.UID]()
+	for _, pod := range pods {
+		onNode.Insert(pod.UID)
+	}
+	var gone []podInfo
+	d.mu.Lock()
+	for _, p := range d.fleetHeld {
+		if !onNode.Has(p.UID) {
+			gone = append(gone, p)
+		}
+	}
+	d.mu.Unlock()
+	if len(gone) == 0 {
+		return
+	}
+	if err := d.fleetBudget.release(ctx, gone); err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to release fleet disruption budget", "pods", len(gone))
+		return
+	}
+	d.mu.Lock()
+	for _, p := range gone {
+		delete(d.fleetHeld, p.key())
+	}
+	d.mu.Unlock()
+}
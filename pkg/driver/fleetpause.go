@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// fleetPauseSyncTimeout bounds how long WatchFleetPause waits for the
+// first read of the ConfigMap.
+const fleetPauseSyncTimeout = 10 * time.Second
+
+// FleetPausedKey is the key in Config.FleetPauseConfigMap that pauses
+// eviction on every driver while set to "true".
+const FleetPausedKey = "paused"
+
+// WatchFleetPause watches Config.FleetPauseConfigMap until ctx is done and
+// pauses or resumes eviction as its FleetPausedKey changes. A missing
+// ConfigMap means not paused. It returns once the ConfigMap has been read,
+// or after fleetPauseSyncTimeout, so a driver that restarts mid-incident
+// starts out paused. With no ConfigMap configured it does nothing.
+func (d *DrainService) WatchFleetPause(ctx context.Context) error {
+	if d.config.FleetPauseConfigMap == "" {
+		return nil
+	}
+	namespace, name, ok := strings.Cut(d.config.FleetPauseConfigMap, "/")
+	if !ok || namespace == "" || name == "" {
+		return fmt.Errorf("fleet pause ConfigMap %q must be <namespace>/<name>", d.config.FleetPauseConfigMap)
+	}
+
+	logger := klog.FromContext(ctx)
+	factory := informers.NewSharedInformerFactoryWithOptions(d.kubeClient, 0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+		}),
+	)
+	update := func(obj any) {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok {
+			return
+		}
+		d.setFleetPaused(logger, cm.Data[FleetPausedKey] == "true")
+	}
+	_, err := factory.Core().V1().ConfigMaps().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    update,
+		UpdateFunc: func(_, newObj any) { update(newObj) },
+		DeleteFunc: func(any) { d.setFleetPaused(logger, false) },
+	})
+	if err != nil {
+		return fmt.Errorf("watch fleet pause ConfigMap: %w", err)
+	}
+	factory.Start(ctx.Done())
+	go func() {
+		<-ctx.Done()
+		factory.Shutdown()
+	}()
+
+	syncCtx, cancel := context.WithTimeout(ctx, fleetPauseSyncTimeout)
+	defer cancel()
+	for _, synced := range factory.WaitForCacheSync(syncCtx.Done()) {
+		if !synced {
+			logger.Info("WARNING: fleet pause ConfigMap not read yet, continuing to watch it", "configMap", d.config.FleetPauseConfigMap, "timeout", fleetPauseSyncTimeout)
+			return nil
+		}
+	}
+	logger.Info("Watching fleet pause ConfigMap", "configMap", d.config.FleetPauseConfigMap, "paused", d.isPaused())
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
+)
+
+// maxDrainHistory is how many finished drains ListRecentDrains can return.
+const maxDrainHistory = 16
+
+// drainHistory is a fixed-size ring buffer of finished drains. It is
+// guarded by DrainService.mu.
+type drainHistory struct {
+	records []*drainpbv1alpha1.DrainRecord
+	next    int // slot the next record overwrites once full
+}
+
+// add stores r, evicting the oldest record once the buffer is full.
+func (h *drainHistory) add(r *drainpbv1alpha1.DrainRecord) {
+	if len(h.records) < maxDrainHistory {
+		h.records = append(h.records, r)
+		return
+	}
+	h.records[h.next] = r
+	h.next = (h.next + 1) % maxDrainHistory
+}
+
+// find returns the newest record of the drain of nodeName for event, or
+// nil if none is stored.
+func (h *drainHistory) find(nodeName, event string) *drainpbv1alpha1.DrainRecord {
+	for i := range h.records {
+		idx := (h.next - 1 - i + 2*len(h.records)) % len(h.records)
+		if r := h.records[idx]; r.GetNodeName() == nodeName && r.GetEventName() == event {
+			return r
+		}
+	}
+	return nil
+}
+
+// newestFirst returns copies of the stored records, most recent first.
+func (h *drainHistory) newestFirst() []*drainpbv1alpha1.DrainRecord {
+	out := make([]*drainpbv1alpha1.DrainRecord, 0, len(h.records))
+	for i := range h.records {
+		// The newest record sits just before next.
+		idx := (h.next - 1 - i + 2*len(h.records)) % len(h.records)
+		out = append(out, proto.Clone(h.records[idx]).(*drainpbv1alpha1.DrainRecord))
+	}
+	return out
+}
+
+// ListRecentDrains returns the most recently finished drains, newest first.
+func (d *DrainService) ListRecentDrains(_ context.Context, _ *drainpbv1alpha1.ListRecentDrainsRequest) (*drainpbv1alpha1.ListRecentDrainsResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return &drainpbv1alpha1.ListRecentDrainsResponse{Drains: d.history.newestFirst()}, nil
+}
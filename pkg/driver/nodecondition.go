@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// DrainInProgressCondition is the Node condition the driver maintains
+// with Config.ReportDrainCondition. It is True while a drain is running
+// and False once it has finished, with the outcome as the reason.
+const DrainInProgressCondition corev1.NodeConditionType = "DrainInProgress"
+
+// drainConditionReasons maps the drain's LifecycleConditions to
+// DrainInProgressCondition reasons.
+var drainConditionReasons = map[string]string{
+	DrainStarted:  "Draining",
+	DrainPaused:   "DrainPaused",
+	DrainComplete: "DrainComplete",
+	DrainFailed:   "DrainFailed",
+	SLAExceeded:   "SLAExceeded",
+}
+
+// reportDrainCondition sets DrainInProgressCondition on nodeName from the
+// drain's current LifecycleCondition. It patches only that condition in
+// the node status, and is best-effort: failures are logged, never
+// returned.
+func (d *DrainService) reportDrainCondition(ctx context.Context, nodeName, condition, message string) {
+	if !d.config.ReportDrainCondition {
+		return
+	}
+	reason, ok := drainConditionReasons[condition]
+	if !ok {
+		return // e.g. node-deleted: there is no node to patch
+	}
+	status := corev1.ConditionFalse
+	if condition == DrainStarted || condition == DrainPaused {
+		status = corev1.ConditionTrue
+	}
+
+	now := metav1.Now()
+	c := map[string]any{
+		"type":              DrainInProgressCondition,
+		"status":            status,
+		"reason":            reason,
+		"message":           message,
+		"lastHeartbeatTime": now,
+	}
+	// Leave lastTransitionTime alone while the status holds, so it says
+	// when the drain started or finished.
+	d.mu.Lock()
+	if d.drainConditionStatus != status {
+		c["lastTransitionTime"] = now
+	}
+	d.mu.Unlock()
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{"conditions": []any{c}},
+	})
+	if err == nil {
+		err = d.patchNodeStatus(ctx, nodeName, patch)
+	}
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to update node condition", "node", nodeName, "condition", DrainInProgressCondition)
+		return
+	}
+	d.mu.Lock()
+	d.drainConditionStatus = status
+	d.mu.Unlock()
+}
+
+// drainProgressMessage describes a drain still waiting on remaining pods.
+func drainProgressMessage(remaining int) string {
+	return fmt.Sprintf("%d pod(s) remaining", remaining)
+}
+
+// patchNodeStatus applies a strategic merge patch to the node's status,
+// bounded by the node operation timeout.
+func (d *DrainService) patchNodeStatus(ctx context.Context, nodeName string, patch []byte) error {
+	ctx, cancel := d.nodeOpContext(ctx)
+	defer cancel()
+	_, err := d.kubeClient.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager}, "status")
+	return err
+}
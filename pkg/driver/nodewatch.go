@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// nodeWatcher watches a single node and calls onUncordon whenever the
+// node's spec.unschedulable flips from true to false.
+type nodeWatcher struct {
+	factory informers.SharedInformerFactory
+	stopCh  chan struct{}
+}
+
+// newNodeWatcher creates a watcher for nodeName. It does not start
+// watching until start is called.
+func newNodeWatcher(kubeClient kubernetes.Interface, nodeName string, onUncordon func(nodeName string)) *nodeWatcher {
+	factory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0,
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", nodeName).String()
+		}),
+	)
+	_, err := factory.Core().V1().Nodes().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			oldNode, ok := oldObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			newNode, ok := newObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			if oldNode.Spec.Unschedulable && !newNode.Spec.Unschedulable {
+				onUncordon(newNode.Name)
+			}
+		},
+	})
+	if err != nil {
+		klog.Background().Error(err, "Failed to add node event handler", "node", nodeName)
+	}
+	return &nodeWatcher{
+		factory: factory,
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// start begins the list+watch in the background.
+func (w *nodeWatcher) start() {
+	w.factory.Start(w.stopCh)
+}
+
+// stop ends the watch and waits for the informer goroutines to exit.
+func (w *nodeWatcher) stop() {
+	close(w.stopCh)
+	w.factory.Shutdown()
+}
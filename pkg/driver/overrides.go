@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// nodeOverrides holds the eviction tunables a node's annotations override
+// for a drain of that node. Unset fields fall back to the Config.
+type nodeOverrides struct {
+	GracePeriod      *int64
+	EvictionStrategy EvictionStrategy
+	DrainTimeout     *time.Duration
+}
+
+// parseNodeOverrides reads the override annotations from a node's
+// annotations. Invalid values are skipped and reported in the returned
+// error, so one bad annotation doesn't discard the others.
+func parseNodeOverrides(annotations map[string]string) (nodeOverrides, error) {
+	var o nodeOverrides
+	var errs []error
+
+	if v, ok := annotations[GracePeriodAnnotation]; ok {
+		seconds, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || seconds < 0 {
+			errs = append(errs, fmt.Errorf("%s: %q is not a non-negative integer", GracePeriodAnnotation, v))
+		} else {
+			o.GracePeriod = &seconds
+		}
+	}
+	if v, ok := annotations[EvictionStrategyAnnotation]; ok {
+		switch s := EvictionStrategy(v); s {
+		case EvictionStrategyEviction, EvictionStrategyDelete:
+			o.EvictionStrategy = s
+		default:
+			errs = append(errs, fmt.Errorf("%s: %q must be %q or %q", EvictionStrategyAnnotation, v, EvictionStrategyEviction, EvictionStrategyDelete))
+		}
+	}
+	if v, ok := annotations[DrainTimeoutAnnotation]; ok {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout < 0 {
+			errs = append(errs, fmt.Errorf("%s: %q is not a non-negative duration", DrainTimeoutAnnotation, v))
+		} else {
+			o.DrainTimeout = &timeout
+		}
+	}
+	return o, errors.Join(errs...)
+}
+
+// gracePeriod returns the grace period for the active drain, -1 meaning
+// the pod's own. d.mu must be held.
+func (d *DrainService) gracePeriod() int64 {
+	if d.overrides.GracePeriod != nil {
+		return *d.overrides.GracePeriod
+	}
+	return d.config.GracePeriod
+}
+
+// evictionStrategy returns the eviction strategy for the active drain.
+// d.mu must be held.
+func (d *DrainService) evictionStrategy() EvictionStrategy {
+	if d.overrides.EvictionStrategy != "" {
+		return d.overrides.EvictionStrategy
+	}
+	return d.config.EvictionStrategy
+}
+
+// drainTimeout returns the drain timeout for the active drain. d.mu must
+// be held.
+func (d *DrainService) drainTimeout() time.Duration {
+	if d.overrides.DrainTimeout != nil {
+		return *d.overrides.DrainTimeout
+	}
+	return d.config.DrainTimeout
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseNodeOverrides(t *testing.T) {
+	gracePeriod := int64(30)
+	drainTimeout := 15 * time.Minute
+	var noTimeout time.Duration
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        nodeOverrides
+		wantErr     bool
+	}{
+		{
+			name: "valid annotations",
+			annotations: map[string]string{
+				GracePeriodAnnotation:      "30",
+				EvictionStrategyAnnotation: string(EvictionStrategyDelete),
+				DrainTimeoutAnnotation:     "15m",
+			},
+			want: nodeOverrides{
+				GracePeriod:      &gracePeriod,
+				EvictionStrategy: EvictionStrategyDelete,
+				DrainTimeout:     &drainTimeout,
+			},
+		},
+		{
+			name:        "no annotations",
+			annotations: nil,
+		},
+		{
+			name:        "unknown key",
+			annotations: map[string]string{"drain.slm.k8s.io/unknown": "1"},
+		},
+		{
+			name:        "empty grace period",
+			annotations: map[string]string{GracePeriodAnnotation: ""},
+			wantErr:     true,
+		},
+		{
+			name:        "empty eviction strategy",
+			annotations: map[string]string{EvictionStrategyAnnotation: ""},
+			wantErr:     true,
+		},
+		{
+			name:        "malformed grace period",
+			annotations: map[string]string{GracePeriodAnnotation: "thirty"},
+			wantErr:     true,
+		},
+		{
+			name:        "malformed drain timeout",
+			annotations: map[string]string{DrainTimeoutAnnotation: "15"},
+			wantErr:     true,
+		},
+		{
+			name:        "negative grace period",
+			annotations: map[string]string{GracePeriodAnnotation: "-1"},
+			wantErr:     true,
+		},
+		{
+			name:        "negative drain timeout",
+			annotations: map[string]string{DrainTimeoutAnnotation: "-5m"},
+			wantErr:     true,
+		},
+		{
+			name:        "unsupported eviction strategy",
+			annotations: map[string]string{EvictionStrategyAnnotation: "force"},
+			wantErr:     true,
+		},
+		{
+			name: "invalid value keeps the valid ones",
+			annotations: map[string]string{
+				GracePeriodAnnotation:      "-1",
+				EvictionStrategyAnnotation: string(EvictionStrategyEviction),
+				DrainTimeoutAnnotation:     "0s",
+			},
+			want: nodeOverrides{
+				EvictionStrategy: EvictionStrategyEviction,
+				DrainTimeout:     &noTimeout,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseNodeOverrides(tc.annotations)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseNodeOverrides() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("parseNodeOverrides() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
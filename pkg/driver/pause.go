@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
+)
+
+// PauseDrain stops the background eviction from issuing new evictions.
+func (d *DrainService) PauseDrain(ctx context.Context, _ *drainpbv1alpha1.PauseDrainRequest) (*drainpbv1alpha1.PauseStateResponse, error) {
+	d.SetPaused(klog.FromContext(ctx), true)
+	return d.pauseState(), nil
+}
+
+// ResumeDrain lets a paused background eviction continue.
+func (d *DrainService) ResumeDrain(ctx context.Context, _ *drainpbv1alpha1.ResumeDrainRequest) (*drainpbv1alpha1.PauseStateResponse, error) {
+	d.SetPaused(klog.FromContext(ctx), false)
+	return d.pauseState(), nil
+}
+
+// TogglePaused flips the pause state and returns the new state. It backs
+// the SIGUSR1 handler.
+func (d *DrainService) TogglePaused(logger klog.Logger) bool {
+	d.mu.Lock()
+	paused := !d.paused
+	d.mu.Unlock()
+	d.SetPaused(logger, paused)
+	return paused
+}
+
+// SetPaused pauses or resumes eviction on this node. Evictions already
+// sent are not affected; the background eviction blocks before its next
+// one. Eviction stays paused while the fleet pause is on.
+func (d *DrainService) SetPaused(logger klog.Logger, paused bool) {
+	d.updatePause(logger, func() { d.paused = paused })
+}
+
+// setFleetPaused records the state of the fleet-wide pause, see
+// Config.FleetPauseConfigMap.
+func (d *DrainService) setFleetPaused(logger klog.Logger, paused bool) {
+	d.updatePause(logger, func() { d.fleetPaused = paused })
+}
+
+// updatePause applies set, which changes a pause source, and pauses or
+// resumes eviction if that changed whether any source is on.
+func (d *DrainService) updatePause(logger klog.Logger, set func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	was := d.pausedLocked()
+	set()
+	paused := d.pausedLocked()
+	if was == paused {
+		return
+	}
+	if paused {
+		d.resumed = make(chan struct{})
+		logger.Info("Drain paused", "event", d.activeEvent, "local", d.paused, "fleet", d.fleetPaused)
+	} else {
+		close(d.resumed)
+		logger.Info("Drain resumed", "event", d.activeEvent)
+	}
+}
+
+// pausedLocked reports whether eviction is paused locally or fleet-wide.
+// d.mu must be held.
+func (d *DrainService) pausedLocked() bool {
+	return d.paused || d.fleetPaused
+}
+
+// isPaused reports whether eviction is paused.
+func (d *DrainService) isPaused() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.pausedLocked()
+}
+
+// waitIfPaused blocks while eviction is paused. It returns ctx.Err() if
+// the context ends first.
+func (d *DrainService) waitIfPaused(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.pausedLocked() {
+		d.mu.Unlock()
+		return nil
+	}
+	resumed := d.resumed
+	d.mu.Unlock()
+
+	klog.FromContext(ctx).V(3).Info("Eviction paused, waiting for resume")
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *DrainService) pauseState() *drainpbv1alpha1.PauseStateResponse {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return &drainpbv1alpha1.PauseStateResponse{
+		Paused:      d.pausedLocked(),
+		ActiveEvent: d.activeEvent,
+	}
+}
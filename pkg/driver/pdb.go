@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kubectl-server-side-drain/pkg/metrics"
+)
+
+// evictionErrorMessage renders an eviction error for d.evictionErrors.
+// When a PodDisruptionBudget blocked the eviction, the message names the
+// budget and its unhealthyPodEvictionPolicy so operators can tell why the
+// pod is stuck.
+func (d *DrainService) evictionErrorMessage(ctx context.Context, p podInfo, err error) string {
+	if !apierrors.IsTooManyRequests(err) {
+		return err.Error()
+	}
+	pdb, lookupErr := d.coveringPDB(ctx, p)
+	if lookupErr != nil {
+		klog.FromContext(ctx).V(3).Info("Could not resolve PodDisruptionBudget", "pod", p.key(), "err", lookupErr)
+		return err.Error()
+	}
+	if pdb == nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("%v (blocked by PodDisruptionBudget %s, %s)", err, pdb.Name, describeUnhealthyPodEvictionPolicy(pdb))
+}
+
+// pdbCacheTTL is how long a namespace's PodDisruptionBudgets, once
+// listed, are reused. Every endDrain poll checks every pod, so listing
+// per pod would cost a List per pod per poll.
+const pdbCacheTTL = 10 * time.Second
+
+// cachedPDBs are the PodDisruptionBudgets of a namespace as listed at
+// fetched.
+type cachedPDBs struct {
+	items   []policyv1.PodDisruptionBudget
+	fetched time.Time
+}
+
+// namespacePDBs returns the PodDisruptionBudgets in namespace, listed at
+// most pdbCacheTTL ago. Failed lists are not cached.
+func (d *DrainService) namespacePDBs(ctx context.Context, namespace string) ([]policyv1.PodDisruptionBudget, error) {
+	d.mu.Lock()
+	cached, ok := d.pdbCache[namespace]
+	d.mu.Unlock()
+	if ok && time.Since(cached.fetched) < pdbCacheTTL {
+		return cached.items, nil
+	}
+	pdbs, err := d.kubeClient.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	d.pdbCache[namespace] = cachedPDBs{items: pdbs.Items, fetched: time.Now()}
+	d.mu.Unlock()
+	return pdbs.Items, nil
+}
+
+// coveringPDB returns the first PodDisruptionBudget in the pod's namespace
+// whose selector matches the pod, or nil if none does.
+func (d *DrainService) coveringPDB(ctx context.Context, p podInfo) (*policyv1.PodDisruptionBudget, error) {
+	pod, err := d.kubeClient.CoreV1().Pods(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pdbs, err := d.namespacePDBs(ctx, p.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pdbs {
+		if pdbSelects(&pdbs[i], pod) {
+			return &pdbs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// pdbCovers reports whether a PodDisruptionBudget in the pod's namespace
+// selects pod. It returns an error if the budgets cannot be listed, and
+// the caller must then neither evict nor skip the pod.
+func (d *DrainService) pdbCovers(ctx context.Context, pod *corev1.Pod) (bool, error) {
+	pdbs, err := d.namespacePDBs(ctx, pod.Namespace)
+	if err != nil {
+		return false, err
+	}
+	for i := range pdbs {
+		if pdbSelects(&pdbs[i], pod) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// pdbSelects reports whether pdb's selector matches pod. As for policy/v1
+// in the disruption controller, a nil selector matches nothing and an
+// empty one every pod in the namespace.
+func pdbSelects(pdb *policyv1.PodDisruptionBudget, pod *corev1.Pod) bool {
+	if pdb.Spec.Selector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(pod.Labels))
+}
+
+// describeUnhealthyPodEvictionPolicy explains what the budget's policy
+// means for a blocked eviction. An unset policy behaves as IfHealthyBudget.
+func describeUnhealthyPodEvictionPolicy(pdb *policyv1.PodDisruptionBudget) string {
+	policy := policyv1.IfHealthyBudget
+	if pdb.Spec.UnhealthyPodEvictionPolicy != nil {
+		policy = *pdb.Spec.UnhealthyPodEvictionPolicy
+	}
+	switch policy {
+	case policyv1.AlwaysAllow:
+		return fmt.Sprintf("unhealthyPodEvictionPolicy=%s: unhealthy pods are always evictable, so this healthy pod waits for the budget to allow a disruption", policy)
+	default:
+		return fmt.Sprintf("unhealthyPodEvictionPolicy=%s: pods, healthy or not, are only evictable while %d pod(s) stay healthy", policy, pdb.Status.DesiredHealthy)
+	}
+}
+
+// notePDBBlocked records the first time an eviction of p was rejected by a
+// PodDisruptionBudget in the active drain.
+func (d *DrainService) notePDBBlocked(p podInfo) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.pdbBlockedSince[p.key()]; !ok {
+		d.pdbBlockedSince[p.key()] = time.Now()
+	}
+}
+
+// observePDBBlocked forgets blocked pods that are no longer remaining,
+// updates the longest-blocked gauge, and logs once for each pod blocked
+// for longer than Config.PDBBlockedWarnAfter.
+func (d *DrainService) observePDBBlocked(logger klog.Logger, nodeName string, remaining []podInfo) {
+	still := make(map[string]bool, len(remaining))
+	for _, p := range remaining {
+		still[p.key()] = true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var longest time.Duration
+	for key, since := range d.pdbBlockedSince {
+		if !still[key] {
+			delete(d.pdbBlockedSince, key)
+			delete(d.pdbBlockedWarned, key)
+			continue
+		}
+		blocked := time.Since(since)
+		longest = max(longest, blocked)
+		if d.config.PDBBlockedWarnAfter > 0 && blocked >= d.config.PDBBlockedWarnAfter && !d.pdbBlockedWarned[key] {
+			d.pdbBlockedWarned[key] = true
+			logger.Info("WARNING: eviction blocked by PodDisruptionBudget",
+				"node", nodeName,
+				"pod", key,
+				"blockedFor", blocked.Round(time.Second),
+				"lastError", d.evictionErrors[key],
+			)
+		}
+	}
+	metrics.LongestPDBBlockedEvictionSeconds.Set(longest.Seconds())
+}
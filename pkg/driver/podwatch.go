@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// podWatcher keeps a local cache of the pods bound to a single node for
+// the duration of a drain. The informer re-lists and re-establishes the
+// watch on its own whenever the watch is closed or errors, so the cache
+// converges even across API server restarts.
+type podWatcher struct {
+	factory  informers.SharedInformerFactory
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+}
+
+// newPodWatcher creates a watcher for the pods matching fieldSelector,
+// which must at least select on spec.nodeName. It does not start watching
+// until start is called.
+func newPodWatcher(kubeClient kubernetes.Interface, fieldSelector string) *podWatcher {
+	factory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0,
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fieldSelector
+		}),
+	)
+	return &podWatcher{
+		factory:  factory,
+		informer: factory.Core().V1().Pods().Informer(),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// start begins the list+watch in the background.
+func (w *podWatcher) start() {
+	w.factory.Start(w.stopCh)
+}
+
+// stop ends the watch and waits for the informer goroutines to exit.
+func (w *podWatcher) stop() {
+	close(w.stopCh)
+	w.factory.Shutdown()
+}
+
+// hasSynced reports whether the initial list has completed.
+func (w *podWatcher) hasSynced() bool {
+	return w.informer.HasSynced()
+}
+
+// pods returns the pods currently in the cache.
+func (w *podWatcher) pods() []*corev1.Pod {
+	objs := w.informer.GetStore().List()
+	pods := make([]*corev1.Pod, 0, len(objs))
+	for _, obj := range objs {
+		if pod, ok := obj.(*corev1.Pod); ok {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"k8s.io/klog/v2"
+
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
+)
+
+// PreviewDrain reports what a drain of the node would evict and why every
+// other pod would be skipped. It is read-only: nothing is cordoned or
+// evicted.
+func (d *DrainService) PreviewDrain(ctx context.Context, req *drainpbv1alpha1.PreviewDrainRequest) (*drainpbv1alpha1.PreviewDrainResponse, error) {
+	logger := klog.FromContext(ctx)
+	logger.V(3).Info("PreviewDrain called", "node", req.GetNodeName())
+
+	targetNode := req.GetNodeName()
+	if targetNode == "" {
+		targetNode = d.nodeName
+	}
+
+	pods, err := d.listNodePods(ctx, targetNode)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "list pods: %v", err)
+	}
+
+	resp := &drainpbv1alpha1.PreviewDrainResponse{NodeName: targetNode}
+	for _, pod := range pods {
+		ref := &drainpbv1alpha1.PodReference{Namespace: pod.Namespace, Name: pod.Name}
+		if reason := d.skipReason(ctx, pod); reason != "" {
+			resp.Skipped = append(resp.Skipped, &drainpbv1alpha1.SkippedPod{Pod: ref, Reason: reason})
+			continue
+		}
+		resp.Evictable = append(resp.Evictable, ref)
+	}
+	return resp, nil
+}
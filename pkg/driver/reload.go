@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Reload replaces the hot-reloadable fields of the service's Config with
+// those of config: EvictionTimeout, GracePeriod, ExcludedNamespaces and
+// PodSelector. All other fields of config are ignored. A drain in
+// progress keeps its settings; the new values take effect once it
+// finishes.
+func (d *DrainService) Reload(logger klog.Logger, config Config) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.activeEvent != "" {
+		d.pendingReload = &config
+		logger.Info("Configuration reloaded, applying after the active drain", "event", d.activeEvent)
+		return
+	}
+	d.applyReload(config)
+	logger.Info("Configuration reloaded")
+}
+
+// applyReload copies the hot-reloadable fields of config. d.mu must be held.
+func (d *DrainService) applyReload(config Config) {
+	d.config.EvictionTimeout = config.EvictionTimeout
+	d.config.GracePeriod = config.GracePeriod
+	d.config.ExcludedNamespaces = config.ExcludedNamespaces
+	d.config.PodSelector = config.PodSelector
+}
+
+// evictionTimeout returns the bound on each eviction call, which Reload
+// may change. d.mu must be held.
+func (d *DrainService) evictionTimeout() time.Duration {
+	return d.config.EvictionTimeout
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// unmanaged reports whether pod is subject to Config.UnmanagedPods: it has
+// no controller or, with Config.StandaloneReplicaSetsUnmanaged, its
+// controller is a ReplicaSet that no Deployment controls.
+func (d *DrainService) unmanaged(ctx context.Context, pod *corev1.Pod) bool {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return true
+	}
+	if !d.config.StandaloneReplicaSetsUnmanaged || ref.Kind != "ReplicaSet" {
+		return false
+	}
+	return d.standaloneReplicaSet(ctx, pod.Namespace, ref)
+}
+
+// standaloneReplicaSet reports whether the ReplicaSet ref points to has no
+// controller of its own. Answers are cached for the active drain. A
+// ReplicaSet that can't be read counts as standalone, so the caller errs
+// on the side of not evicting.
+func (d *DrainService) standaloneReplicaSet(ctx context.Context, namespace string, ref *metav1.OwnerReference) bool {
+	d.mu.Lock()
+	standalone, ok := d.standaloneReplicaSets[ref.UID]
+	d.mu.Unlock()
+	if ok {
+		return standalone
+	}
+
+	rs, err := d.kubeClient.AppsV1().ReplicaSets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		standalone = true // the pod is orphaned
+	case err != nil:
+		klog.FromContext(ctx).Error(err, "Could not read ReplicaSet, treating its pods as unmanaged", "replicaSet", klog.KRef(namespace, ref.Name))
+		return true
+	default:
+		standalone = rs.UID != ref.UID || metav1.GetControllerOf(rs) == nil
+	}
+	d.mu.Lock()
+	d.standaloneReplicaSets[ref.UID] = standalone
+	d.mu.Unlock()
+	return standalone
+}
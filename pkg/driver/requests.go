@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// podRequests returns the CPU and memory pod requests for scheduling: the
+// larger of the sum over its containers and each init container, plus
+// the pod overhead. Resources no container requests are left out of the
+// list, so a pod without requests gets an empty one.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	reqs := corev1.ResourceList{}
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		var sum resource.Quantity
+		found := false
+		for _, c := range pod.Spec.Containers {
+			if q, ok := c.Resources.Requests[name]; ok {
+				sum.Add(q)
+				found = true
+			}
+		}
+		for _, c := range pod.Spec.InitContainers {
+			if q, ok := c.Resources.Requests[name]; ok {
+				found = true
+				if q.Cmp(sum) > 0 {
+					sum = q.DeepCopy()
+				}
+			}
+		}
+		if q, ok := pod.Spec.Overhead[name]; ok {
+			sum.Add(q)
+			found = true
+		}
+		if found {
+			reqs[name] = sum
+		}
+	}
+	return reqs
+}
+
+// requestsSummary is the total CPU and memory requested by a set of pods,
+// and how many of them request neither.
+type requestsSummary struct {
+	CPU, Memory   resource.Quantity
+	NoRequestPods int
+}
+
+// summarizeRequests totals the requests of pods.
+func summarizeRequests(pods []podInfo) requestsSummary {
+	var s requestsSummary
+	for _, p := range pods {
+		if len(p.Requests) == 0 {
+			s.NoRequestPods++
+			continue
+		}
+		if q, ok := p.Requests[corev1.ResourceCPU]; ok {
+			s.CPU.Add(q)
+		}
+		if q, ok := p.Requests[corev1.ResourceMemory]; ok {
+			s.Memory.Add(q)
+		}
+	}
+	return s
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// rescheduleCheckInterval is how often waitForReplacementReady looks for
+// a replacement pod.
+const rescheduleCheckInterval = 2 * time.Second
+
+// rescheduleBackoff spaces out verifyReschedule's checks, which run in the
+// background for every evicted pod: from rescheduleCheckInterval, doubling
+// up to 30s.
+var rescheduleBackoff = wait.Backoff{
+	Duration: rescheduleCheckInterval,
+	Factor:   2,
+	Steps:    5,
+	Cap:      30 * time.Second,
+}
+
+// verifyReschedule checks in the background that the controller of the
+// evicted pod p schedules a replacement to a node other than nodeName
+// within Config.VerifyRescheduleTimeout. If none shows up, it logs a
+// warning and records it in d.evictionErrors; the eviction still counts
+// as done. Unowned pods have no replacement and are not checked.
+func (d *DrainService) verifyReschedule(ctx context.Context, nodeName string, p podInfo) {
+	if d.config.VerifyRescheduleTimeout <= 0 || p.OwnerUID == "" {
+		return
+	}
+	evictedAt := time.Now()
+	go func() {
+		logger := klog.FromContext(ctx)
+		timeout := d.config.VerifyRescheduleTimeout
+		pollCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		err := rescheduleBackoff.DelayFunc().Until(pollCtx, true, false, func(ctx context.Context) (bool, error) {
+			return d.replacementScheduled(ctx, nodeName, p, evictedAt, false), nil
+		})
+		if err == nil {
+			logger.V(3).Info("Evicted pod's replacement scheduled", "pod", p.key(), "owner", p.owner())
+			return
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if ctx.Err() != nil {
+			return // the drain finished first
+		}
+		msg := fmt.Sprintf("evicted, but its %s scheduled no replacement on another node within %s", p.OwnerKind, timeout)
+		d.evictionErrors[p.key()] = msg
+		logger.Info("WARNING: evicted pod was not rescheduled", "node", nodeName, "pod", p.key(), "owner", p.owner(), "timeout", timeout)
+	}()
+}
+
+// replacementScheduled reports whether a pod created by p's controller
+// since evictedAt is scheduled to a node other than nodeName, and if
+// requireReady also Ready for at least Config.MinReadySeconds. List errors
+// count as not yet. Only pods matching the owner's selector are listed.
+func (d *DrainService) replacementScheduled(ctx context.Context, nodeName string, p podInfo, evictedAt time.Time, requireReady bool) bool {
+	opts := metav1.ListOptions{LabelSelector: d.ownerSelector(ctx, p)}
+	pods, err := d.kubeClient.CoreV1().Pods(p.Namespace).List(ctx, opts)
+	if err != nil {
+		return false
+	}
+	// Allow for clock skew between the driver and the API server.
+	since := evictedAt.Add(-time.Minute)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		ref := metav1.GetControllerOf(pod)
+		if ref == nil || ref.UID != p.OwnerUID || pod.UID == p.UID {
+			continue
+		}
+		if pod.Spec.NodeName == "" || pod.Spec.NodeName == nodeName || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if !pod.CreationTimestamp.Time.After(since) {
+			continue
+		}
+		if !requireReady || podAvailable(pod, d.config.MinReadySeconds) {
+			return true
+		}
+	}
+	return false
+}
+
+// ownerSelector returns the label selector of the ReplicaSet, StatefulSet
+// or Job that controls p, so replacementScheduled lists only its
+// pods. Answers are cached for the active drain. It returns "", matching
+// the whole namespace, for other owners and for owners that can't be read.
+func (d *DrainService) ownerSelector(ctx context.Context, p podInfo) string {
+	d.mu.Lock()
+	selector, ok := d.ownerSelectors[p.OwnerUID]
+	d.mu.Unlock()
+	if ok {
+		return selector
+	}
+
+	var labelSelector *metav1.LabelSelector
+	var err error
+	switch p.OwnerKind {
+	case "ReplicaSet":
+		var rs *appsv1.ReplicaSet
+		if rs, err = d.kubeClient.AppsV1().ReplicaSets(p.Namespace).Get(ctx, p.OwnerName, metav1.GetOptions{}); err == nil {
+			labelSelector = rs.Spec.Selector
+		}
+	case "StatefulSet":
+		var sts *appsv1.StatefulSet
+		if sts, err = d.kubeClient.AppsV1().StatefulSets(p.Namespace).Get(ctx, p.OwnerName, metav1.GetOptions{}); err == nil {
+			labelSelector = sts.Spec.Selector
+		}
+	case "Job":
+		var job *batchv1.Job
+		if job, err = d.kubeClient.BatchV1().Jobs(p.Namespace).Get(ctx, p.OwnerName, metav1.GetOptions{}); err == nil {
+			labelSelector = job.Spec.Selector
+		}
+	}
+	if err != nil {
+		// Not cached, so the next check tries again.
+		klog.FromContext(ctx).V(4).Info("Could not read pod owner, listing the whole namespace", "pod", p.key(), "owner", p.owner(), "err", err)
+		return ""
+	}
+	if labelSelector != nil {
+		if s, err := metav1.LabelSelectorAsSelector(labelSelector); err == nil {
+			selector = s.String()
+		}
+	}
+	d.mu.Lock()
+	d.ownerSelectors[p.OwnerUID] = selector
+	d.mu.Unlock()
+	return selector
+}
+
+// podAvailable reports whether pod has been Ready for at least
+// minReadySeconds, as a Deployment counts available replicas.
+func podAvailable(pod *corev1.Pod, minReadySeconds int32) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			minReady := time.Duration(minReadySeconds) * time.Second
+			return c.Status == corev1.ConditionTrue && (minReady == 0 || time.Since(c.LastTransitionTime.Time) >= minReady)
+		}
+	}
+	return false
+}
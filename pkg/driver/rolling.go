@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// rollingEviction is the last pod evicted for an owner under
+// Config.RollingEviction.
+type rollingEviction struct {
+	pod       podInfo
+	evictedAt time.Time
+}
+
+// rollingOrder reorders pods into rounds holding at most one pod per
+// controller, so while the driver waits for one owner's replacement,
+// the other owners' pods have been evicted already. Unowned pods go in
+// the first round. Order within a round is kept.
+func rollingOrder(pods []podInfo) []podInfo {
+	round := make(map[types.UID]int)
+	var rounds [][]podInfo
+	for _, p := range pods {
+		r := 0
+		if p.OwnerUID != "" {
+			r = round[p.OwnerUID]
+			round[p.OwnerUID]++
+		}
+		if r == len(rounds) {
+			rounds = append(rounds, nil)
+		}
+		rounds[r] = append(rounds[r], p)
+	}
+	ordered := make([]podInfo, 0, len(pods))
+	for _, r := range rounds {
+		ordered = append(ordered, r...)
+	}
+	return ordered
+}
+
+// waitForReplacementReady waits until a replacement for the evicted pod
+// prev is Ready on another node, for at most Config.RollingEvictionTimeout.
+// On timeout it logs a warning and returns, so one stuck owner can't hang
+// the drain. It returns an error only if ctx is done.
+func (d *DrainService) waitForReplacementReady(ctx context.Context, nodeName string, prev rollingEviction) error {
+	logger := klog.FromContext(ctx)
+	timeout := d.config.RollingEvictionTimeout
+	logger.V(3).Info("Waiting for replacement to become Ready before evicting the owner's next pod", "pod", prev.pod.key(), "owner", prev.pod.owner())
+	err := wait.PollUntilContextTimeout(ctx, rescheduleCheckInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		return d.replacementScheduled(ctx, nodeName, prev.pod, prev.evictedAt, true), nil
+	})
+	if err == nil || ctx.Err() != nil {
+		return ctx.Err()
+	}
+	logger.Info("WARNING: no Ready replacement for evicted pod, evicting the owner's next pod anyway", "node", nodeName, "pod", prev.pod.key(), "owner", prev.pod.owner(), "timeout", timeout)
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// CloudProviderShutdownTaintKey is the taint the cloud node lifecycle
+// controller adds to a node whose instance is shut down.
+const CloudProviderShutdownTaintKey = "node.cloudprovider.kubernetes.io/shutdown"
+
+// DrainOnShutdown evicts the evictable pods on the driver's node before
+// the process exits, if the node itself is shutting down: it is cordoned,
+// or carries the node.kubernetes.io/out-of-service or
+// CloudProviderShutdownTaintKey taint. Any other SIGTERM, such as a
+// rolling update of the driver, evicts nothing. The node is cordoned
+// first, through the same path as a drain, and left cordoned. The
+// eviction runs synchronously until the pods are gone or ctx is done. It
+// reports whether it evicted.
+func (d *DrainService) DrainOnShutdown(ctx context.Context) (EvictionSummary, bool) {
+	logger := klog.FromContext(ctx)
+	node, err := d.getNode(ctx, d.nodeName)
+	if err != nil {
+		logger.Error(err, "Could not read node, not evicting on shutdown", "node", d.nodeName)
+		return EvictionSummary{}, false
+	}
+	if !nodeShuttingDown(node) {
+		logger.Info("Node is not shutting down, not evicting on shutdown", "node", d.nodeName)
+		return EvictionSummary{}, false
+	}
+	if _, err := d.cordonNode(ctx, d.nodeName); err != nil {
+		logger.Error(err, "Could not cordon node, not evicting on shutdown", "node", d.nodeName)
+		return EvictionSummary{}, false
+	}
+	return d.evictAllPods(ctx, d.nodeName), true
+}
+
+// nodeShuttingDown reports whether node is cordoned or tainted as shut
+// down or out of service.
+func nodeShuttingDown(node *corev1.Node) bool {
+	return node.Spec.Unschedulable || slices.ContainsFunc(node.Spec.Taints, func(t corev1.Taint) bool {
+		return t.Key == corev1.TaintNodeOutOfService || t.Key == CloudProviderShutdownTaintKey
+	})
+}
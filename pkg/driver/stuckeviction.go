@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// terminationSignalBuffer is how long past its grace period an evicted pod
+// may stay Running before it is flagged as ignoring its termination
+// signal.
+const terminationSignalBuffer = 30 * time.Second
+
+// ignoringTerminationSignal prefixes the d.evictionErrors entry for an
+// evicted pod that is still Running past its grace period.
+const ignoringTerminationSignal = "ignoring termination signal"
+
+// noteIgnoredTermination flags pods on nodeName whose eviction was
+// accepted but which are still Running terminationSignalBuffer after their
+// grace period ended, usually because a container ignores SIGTERM. Slow
+// but normal shutdowns within the grace period are not flagged.
+func (d *DrainService) noteIgnoredTermination(ctx context.Context, nodeName string) {
+	d.mu.Lock()
+	accepted := len(d.acceptedEvictions)
+	w := d.podWatcher
+	d.mu.Unlock()
+	if accepted == 0 {
+		return
+	}
+
+	var pods []*corev1.Pod
+	if w != nil && w.hasSynced() {
+		pods = w.pods()
+	} else {
+		var err error
+		if pods, err = d.listNodePods(ctx, nodeName); err != nil {
+			return // endDrain reports list failures itself
+		}
+	}
+
+	logger := klog.FromContext(ctx)
+	now := time.Now()
+	for _, pod := range pods {
+		// The API server sets the deletion timestamp to the end of the
+		// pod's grace period.
+		if pod.DeletionTimestamp == nil || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		overdue := now.Sub(pod.DeletionTimestamp.Time)
+		if overdue < terminationSignalBuffer {
+			continue
+		}
+		key := pod.Namespace + "/" + pod.Name
+		msg := fmt.Sprintf("%s: still Running %s after its grace period ended", ignoringTerminationSignal, overdue.Round(time.Second))
+		d.mu.Lock()
+		if !d.acceptedEvictions[key] {
+			d.mu.Unlock()
+			continue
+		}
+		first := !strings.HasPrefix(d.evictionErrors[key], ignoringTerminationSignal)
+		d.evictionErrors[key] = msg
+		d.mu.Unlock()
+		if first {
+			logger.Info("Evicted pod is ignoring its termination signal", "node", nodeName, "pod", key, "overdue", overdue.Round(time.Second))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceTaintKey is the NoExecute taint put on the node in
+// EvictionModeTaint. Pods that tolerate it are never evicted.
+const MaintenanceTaintKey = "drain.slm.k8s.io/maintenance"
+
+// addMaintenanceTaint adds the MaintenanceTaintKey:NoExecute taint to node
+// and reports whether it was missing.
+func addMaintenanceTaint(node *corev1.Node) bool {
+	for _, t := range node.Spec.Taints {
+		if t.Key == MaintenanceTaintKey && t.Effect == corev1.TaintEffectNoExecute {
+			return false
+		}
+	}
+	now := metav1.Now()
+	node.Spec.Taints = append(node.Spec.Taints, corev1.Taint{
+		Key:       MaintenanceTaintKey,
+		Effect:    corev1.TaintEffectNoExecute,
+		TimeAdded: &now,
+	})
+	return true
+}
+
+// removeMaintenanceTaint removes the taint added by addMaintenanceTaint
+// from node and reports whether it was there.
+func removeMaintenanceTaint(node *corev1.Node) bool {
+	n := len(node.Spec.Taints)
+	node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, func(t corev1.Taint) bool {
+		return t.Key == MaintenanceTaintKey && t.Effect == corev1.TaintEffectNoExecute
+	})
+	return len(node.Spec.Taints) != n
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// tierCheckInterval is how often waitForTierGone lists the node's pods.
+const tierCheckInterval = 2 * time.Second
+
+// podTiers returns the tier of each of pods under Config.EvictByTier,
+// keyed by namespace/name, or nil if tiers are not used. A missing tier
+// counts as 0.
+func (d *DrainService) podTiers(ctx context.Context, pods []podInfo) map[string]int {
+	if !d.config.EvictByTier {
+		return nil
+	}
+	tiers := make(map[string]int, len(pods))
+	for _, p := range pods {
+		tiers[p.key()] = d.ownerTier(ctx, p)
+	}
+	return tiers
+}
+
+// ownerTier returns the TierAnnotation of the Deployment, StatefulSet or
+// standalone ReplicaSet that controls p, and 0 for any other pod. Answers
+// are cached for the active drain. An owner that can't be read, or whose
+// annotation is not an integer, counts as tier 0.
+func (d *DrainService) ownerTier(ctx context.Context, p podInfo) int {
+	if p.OwnerKind != "ReplicaSet" && p.OwnerKind != "StatefulSet" {
+		return 0
+	}
+	d.mu.Lock()
+	tier, ok := d.ownerTiers[p.OwnerUID]
+	d.mu.Unlock()
+	if ok {
+		return tier
+	}
+
+	logger := klog.FromContext(ctx)
+	apps := d.kubeClient.AppsV1()
+	var owner metav1.Object
+	var err error
+	if p.OwnerKind == "StatefulSet" {
+		owner, err = apps.StatefulSets(p.Namespace).Get(ctx, p.OwnerName, metav1.GetOptions{})
+	} else {
+		owner, err = apps.ReplicaSets(p.Namespace).Get(ctx, p.OwnerName, metav1.GetOptions{})
+		if ref := ownerController(owner, err); ref != nil && ref.Kind == "Deployment" {
+			owner, err = apps.Deployments(p.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		// Not cached, so the next sweep tries again.
+		logger.Error(err, "Could not read pod owner, evicting the pod in tier 0", "pod", p.key(), "owner", p.owner())
+		return 0
+	}
+	if value, ok := owner.GetAnnotations()[TierAnnotation]; ok {
+		if tier, err = strconv.Atoi(value); err != nil {
+			logger.Info("WARNING: ignoring invalid tier annotation", "owner", klog.KObj(owner), "annotation", TierAnnotation, "value", value)
+		}
+	}
+	d.mu.Lock()
+	d.ownerTiers[p.OwnerUID] = tier
+	d.mu.Unlock()
+	return tier
+}
+
+// ownerController returns the controller of obj, or nil if obj has none or
+// err is set.
+func ownerController(obj metav1.Object, err error) *metav1.OwnerReference {
+	if err != nil {
+		return nil
+	}
+	return metav1.GetControllerOf(obj)
+}
+
+// holdLaterTiers drops from pending the pods in a higher tier than any of
+// waiting, the pods waiting to retry a failed eviction, so a tier is not
+// started before the ones below it are done.
+func (d *DrainService) holdLaterTiers(ctx context.Context, pending []podInfo, tiers map[string]int, waiting []podInfo) []podInfo {
+	if tiers == nil || len(waiting) == 0 {
+		return pending
+	}
+	lowest := d.ownerTier(ctx, waiting[0])
+	for _, p := range waiting[1:] {
+		lowest = min(lowest, d.ownerTier(ctx, p))
+	}
+	return slices.DeleteFunc(pending, func(p podInfo) bool {
+		return tiers[p.key()] > lowest
+	})
+}
+
+// tierRuns splits pods, sorted by tier, into runs of the same tier. With
+// nil tiers all pods form one run.
+func tierRuns(pods []podInfo, tiers map[string]int) [][]podInfo {
+	var runs [][]podInfo
+	for i, p := range pods {
+		if i == 0 || tiers[p.key()] != tiers[pods[i-1].key()] {
+			runs = append(runs, nil)
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], p)
+	}
+	return runs
+}
+
+// waitForTierGone waits until the pods evicted from a tier have left the
+// node, for at most Config.TierTimeout. On timeout it logs a warning and
+// returns, so one stuck pod can't hang the drain. It returns an error only
+// if ctx is done.
+func (d *DrainService) waitForTierGone(ctx context.Context, nodeName string, tier int, evicted []podInfo) error {
+	if len(evicted) == 0 {
+		return nil
+	}
+	logger := klog.FromContext(ctx)
+	uids := sets.New[types.UID]()
+	for _, p := range evicted {
+		uids.Insert(p.UID)
+	}
+	logger.V(3).Info("Waiting for tier to leave the node before evicting the next", "node", nodeName, "tier", tier, "pods", len(evicted))
+	err := wait.PollUntilContextTimeout(ctx, tierCheckInterval, d.config.TierTimeout, true, func(ctx context.Context) (bool, error) {
+		pods, err := d.listNodePods(ctx, nodeName)
+		if err != nil {
+			logger.V(3).Info("Listing pods failed, retrying", "node", nodeName, "err", err)
+			return false, nil
+		}
+		for _, pod := range pods {
+			if uids.Has(pod.UID) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == nil || ctx.Err() != nil {
+		return ctx.Err()
+	}
+	logger.Info("WARNING: evicted pods of tier still on the node, evicting the next tier anyway", "node", nodeName, "tier", tier, "timeout", d.config.TierTimeout)
+	return nil
+}
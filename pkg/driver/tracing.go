@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer records a span per transition, from cordon to completion for a
+// drain, with an event per eviction. It is a no-op unless the process
+// installs an OpenTelemetry tracer provider.
+var tracer = otel.Tracer("k8s.io/kubectl-server-side-drain/pkg/driver")
+
+// startTransitionSpan starts the span for a transition of nodeName for
+// eventName. Only the span is kept, not its context: the transition spans
+// several gRPC calls.
+func startTransitionSpan(name, nodeName, eventName string) trace.Span {
+	_, span := tracer.Start(context.Background(), name, trace.WithAttributes(
+		attribute.String("kssd.node", nodeName),
+		attribute.String("kssd.event", eventName),
+	))
+	return span
+}
+
+// endTransitionSpan ends span with the transition's terminal condition,
+// marking it failed if errMsg is set. span may be nil.
+func endTransitionSpan(span trace.Span, condition, errMsg string) {
+	if span == nil {
+		return
+	}
+	span.SetAttributes(attribute.String("kssd.condition", condition))
+	if errMsg != "" {
+		span.SetStatus(codes.Error, errMsg)
+	}
+	span.End()
+}
+
+// traceEviction adds an event for the eviction of p to the active drain's
+// span.
+func (d *DrainService) traceEviction(p podInfo, attempt int, err error) {
+	d.mu.Lock()
+	span := d.drainSpan
+	d.mu.Unlock()
+	if span == nil {
+		return
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("kssd.pod", p.key()),
+		attribute.Int("kssd.attempt", attempt),
+	}
+	if owner := p.owner(); owner != "" {
+		attrs = append(attrs, attribute.String("kssd.owner", owner))
+	}
+	if err != nil {
+		attrs = append(attrs, attribute.String("kssd.error", err.Error()))
+	}
+	span.AddEvent("eviction", trace.WithAttributes(attrs...))
+}
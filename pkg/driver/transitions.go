@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
+)
+
+// supportedTransitions are the start and end conditions handled by
+// StartLifecycleTransition and EndLifecycleTransition. Keep it in sync
+// with their switches.
+var supportedTransitions = []struct{ start, end string }{
+	{DrainStarted, DrainComplete},
+	{Uncordoning, MaintenanceComplete},
+}
+
+// ListSupportedTransitions returns the transitions the driver handles.
+func (d *DrainService) ListSupportedTransitions(_ context.Context, _ *drainpbv1alpha1.ListSupportedTransitionsRequest) (*drainpbv1alpha1.ListSupportedTransitionsResponse, error) {
+	resp := &drainpbv1alpha1.ListSupportedTransitionsResponse{}
+	for _, t := range supportedTransitions {
+		resp.Transitions = append(resp.Transitions, &drainpbv1alpha1.SupportedTransition{Start: t.start, End: t.end})
+	}
+	return resp, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/client-go/rest"
+)
+
+// WarningHandler handles API server warnings for the driver's client. It
+// hands warnings for evictions to the eviction that caused them, so they
+// are recorded per pod, and logs all others like client-go's default
+// handler. Set it as rest.Config.WarningHandlerWithContext.
+type WarningHandler struct{}
+
+var _ rest.WarningHandlerWithContext = WarningHandler{}
+
+// HandleWarningHeaderWithContext implements rest.WarningHandlerWithContext.
+func (WarningHandler) HandleWarningHeaderWithContext(ctx context.Context, code int, agent string, message string) {
+	if c, ok := ctx.Value(warningCollectorKey{}).(*warningCollector); ok && code == 299 && message != "" {
+		c.add(message)
+		return
+	}
+	rest.WarningLogger{}.HandleWarningHeaderWithContext(ctx, code, agent, message)
+}
+
+type warningCollectorKey struct{}
+
+// warningCollector gathers the warnings for one request.
+type warningCollector struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (c *warningCollector) add(message string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, message)
+}
+
+func (c *warningCollector) list() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.messages
+}
+
+// withWarningCollector returns a context whose requests' warnings are
+// gathered in the returned collector instead of logged, as long as the
+// client uses WarningHandler.
+func withWarningCollector(ctx context.Context) (context.Context, *warningCollector) {
+	c := &warningCollector{}
+	return context.WithValue(ctx, warningCollectorKey{}, c), c
+}
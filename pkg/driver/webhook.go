@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
+)
+
+// postDrainWebhookTimeout bounds one post-drain webhook notification.
+const postDrainWebhookTimeout = 10 * time.Second
+
+// postDrainNotification is the JSON body POSTed to
+// Config.PostDrainWebhookURL.
+type postDrainNotification struct {
+	NodeName  string    `json:"nodeName"`
+	EventName string    `json:"eventName"`
+	Condition string    `json:"condition"`
+	Evicted   int32     `json:"evicted"`
+	Failed    int32     `json:"failed"`
+	Started   time.Time `json:"started,omitzero"`
+	Finished  time.Time `json:"finished"`
+}
+
+// notifyPostDrain POSTs record to the post-drain webhook, if configured.
+// It does not block the transition: the request runs in the background
+// and failures are only logged.
+func (d *DrainService) notifyPostDrain(ctx context.Context, record *drainpbv1alpha1.DrainRecord) {
+	url := d.config.PostDrainWebhookURL
+	if url == "" || record == nil {
+		return
+	}
+	n := postDrainNotification{
+		NodeName:  record.GetNodeName(),
+		EventName: record.GetEventName(),
+		Condition: record.GetCondition(),
+		Evicted:   record.GetEvicted(),
+		Failed:    record.GetFailed(),
+		Finished:  record.GetFinished().AsTime(),
+	}
+	if record.GetStarted() != nil {
+		n.Started = record.GetStarted().AsTime()
+	}
+	logger := klog.FromContext(ctx)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), postDrainWebhookTimeout)
+		defer cancel()
+		if err := postJSON(ctx, url, n); err != nil {
+			logger.Error(err, "Failed to notify post-drain webhook", "node", n.NodeName, "event", n.EventName, "condition", n.Condition)
+			return
+		}
+		logger.V(3).Info("Notified post-drain webhook", "node", n.NodeName, "event", n.EventName, "condition", n.Condition)
+	}()
+}
+
+// postJSON POSTs v to url as JSON and fails on any non-2xx response.
+func postJSON(ctx context.Context, url string, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
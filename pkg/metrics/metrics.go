@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the Prometheus metrics exported by the drain
+// driver.
+package metrics
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const subsystem = "kssd"
+
+var (
+	// LongestPDBBlockedEvictionSeconds is how long the longest currently
+	// PodDisruptionBudget-blocked eviction has been blocked.
+	LongestPDBBlockedEvictionSeconds = metrics.NewGauge(&metrics.GaugeOpts{
+		Subsystem:      subsystem,
+		Name:           "longest_pdb_blocked_eviction_seconds",
+		Help:           "Time since the first PodDisruptionBudget rejection of the longest-blocked eviction in the active drain, 0 if none is blocked.",
+		StabilityLevel: metrics.ALPHA,
+	})
+
+	// Registered is 1 while the kubelet reports the driver registered and
+	// 0 otherwise, including before the kubelet has reported at all.
+	Registered = metrics.NewGauge(&metrics.GaugeOpts{
+		Subsystem:      subsystem,
+		Name:           "registered",
+		Help:           "1 if the kubelet reported the driver registered in its last registration status, 0 otherwise.",
+		StabilityLevel: metrics.ALPHA,
+	})
+
+	// RegistrationAttemptsTotal counts the registration statuses the
+	// kubelet reported, by result.
+	RegistrationAttemptsTotal = metrics.NewCounterVec(&metrics.CounterOpts{
+		Subsystem:      subsystem,
+		Name:           "registration_attempts_total",
+		Help:           "Number of registration attempts the kubelet reported, by result (success or failure).",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"result"})
+
+	registerOnce sync.Once
+)
+
+// Register registers the driver's metrics with the legacy registry. It is
+// safe to call more than once.
+func Register() {
+	registerOnce.Do(func() {
+		legacyregistry.MustRegister(LongestPDBBlockedEvictionSeconds, Registered, RegistrationAttemptsTotal)
+	})
+}
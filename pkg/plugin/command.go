@@ -20,21 +20,34 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"path"
 	"path/filepath"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	lifecycleapi "k8s.io/api/lifecycle/v1alpha1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -42,12 +55,15 @@ import (
 	"k8s.io/component-base/featuregate"
 	"k8s.io/component-base/logs"
 	logsapi "k8s.io/component-base/logs/api/v1"
+	"k8s.io/component-base/metrics/legacyregistry"
 	"k8s.io/component-base/term"
 	"k8s.io/klog/v2"
 	registerapi "k8s.io/kubelet/pkg/apis/pluginregistration/v1"
 	slmpbv1alpha1 "k8s.io/kubelet/pkg/apis/slm/v1alpha1"
 
+	drainpbv1alpha1 "k8s.io/kubectl-server-side-drain/pkg/apis/drain/v1alpha1"
 	"k8s.io/kubectl-server-side-drain/pkg/driver"
+	"k8s.io/kubectl-server-side-drain/pkg/metrics"
 )
 
 const (
@@ -62,8 +78,40 @@ const (
 	// DefaultKubeletRegistryDir is where the kubelet plugin watcher discovers
 	// registration sockets.
 	DefaultKubeletRegistryDir = "/var/lib/kubelet/plugins_registry"
+
+	// TransitionOwnerAnnotation marks LifecycleTransitions published by
+	// this driver. Its value is the driver name.
+	TransitionOwnerAnnotation = "drain.slm.k8s.io/owner"
+)
+
+// transitionUpdatePolicy controls what startup does with a
+// LifecycleTransition that already exists.
+type transitionUpdatePolicy string
+
+const (
+	// transitionUpdateAlways overwrites the existing spec.
+	transitionUpdateAlways transitionUpdatePolicy = "always"
+	// transitionUpdateIfOwned overwrites the spec only when the existing
+	// transition carries this driver's TransitionOwnerAnnotation.
+	transitionUpdateIfOwned transitionUpdatePolicy = "if-owned"
+	// transitionUpdateNever leaves existing transitions untouched.
+	transitionUpdateNever transitionUpdatePolicy = "never"
 )
 
+// transitionPublishTimeout bounds how long startup keeps retrying to
+// publish a LifecycleTransition while the API server is unavailable.
+const transitionPublishTimeout = 2 * time.Minute
+
+// transitionPublishBackoff spaces out those retries. Once the delay
+// reaches Cap it stays there until transitionPublishTimeout elapses.
+var transitionPublishBackoff = wait.Backoff{
+	Duration: 500 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    10,
+	Cap:      15 * time.Second,
+}
+
 // NewCommand creates the cobra command tree for the drain driver.
 func NewCommand() *cobra.Command {
 	o := logsapi.NewLoggingConfiguration()
@@ -71,8 +119,9 @@ func NewCommand() *cobra.Command {
 	logger := klog.Background()
 
 	cmd := &cobra.Command{
-		Use:  "drain-driver",
-		Long: "SLM driver that implements server-side node drain via the Specialized Lifecycle Management API.",
+		Use:     "drain-driver",
+		Long:    "SLM driver that implements server-side node drain via the Specialized Lifecycle Management API.",
+		Version: version(),
 	}
 
 	sharedFlagSets := cliflag.NamedFlagSets{}
@@ -87,8 +136,69 @@ func NewCommand() *cobra.Command {
 
 	fs = sharedFlagSets.FlagSet("SLM")
 	driverName := fs.String("driver-name", DriverName, "SLM driver name.")
-	evictionTimeout := fs.Duration("eviction-timeout", 30*time.Second, "Timeout for individual pod evictions.")
+	sla := fs.Duration("sla", 5*time.Minute, "SLA for completing a transition, unless set per transition with --drain-sla or --uncordon-sla.")
+	drainSLA := fs.Duration("drain-sla", 0, "SLA of the drain transition (default --sla).")
+	uncordonSLA := fs.Duration("uncordon-sla", 0, "SLA of the maintenance-complete transition (default --sla).")
+	evictionTimeout := fs.Duration("eviction-timeout", 30*time.Second, "Timeout for each individual pod eviction or delete call (0 = no limit).")
 	gracePeriod := fs.Int64("grace-period", -1, "Override for pod termination grace period (-1 = use pod's own).")
+	keepPreStopGracePeriod := fs.Bool("keep-prestop-grace-period", false, "Don't let --grace-period or a node's override shorten the grace period of a pod with a PreStop hook.")
+	nodeUpdateAttempts := fs.Int("node-update-attempts", 5, "Attempts at each cordon or uncordon update that conflicts with another writer of the node, with jittered exponential backoff in between.")
+	podListAttempts := fs.Int("pod-list-attempts", 3, "Attempts at listing the node's pods when the API server returns a transient error such as a timeout or 503, with a short backoff in between.")
+	nodeMutationMethod := fs.String("node-mutation-method", string(driver.NodeMutationPatch), "API verb cordon and uncordon write the node with, to match the driver's RBAC: \"patch\" or \"update\".")
+	maxConcurrentNodeOps := fs.Int("max-concurrent-node-ops", 0, "Maximum node API calls (cordon, uncordon, status patches) in flight at once across all events; further calls wait. 0 = unlimited.")
+	nodeOpTimeout := fs.Duration("node-op-timeout", 10*time.Second, "Timeout for each node Get/Update issued by the driver (0 = no timeout).")
+	drainTimeout := fs.Duration("drain-timeout", 0, "Overall time allowed for a drain before it is reported as failed (0 = wait forever).")
+	enforceEventSLA := fs.Bool("enforce-event-sla", false, "Stop a drain at its LifecycleEvent's deadline (creation time plus transition SLA) and report sla-exceeded.")
+	uncordonAfterDrain := fs.Bool("uncordon-after-drain", false, "Uncordon the node as soon as the drain completes instead of waiting for the maintenance-complete transition.")
+	watchPods := fs.Bool("watch-pods", false, "Watch the node's pods during a drain instead of listing them on every completion check.")
+	excludedNamespaces := fs.StringSlice("excluded-namespaces", nil, "Namespaces whose pods are never evicted.")
+	podFieldSelector := fs.String("pod-field-selector", "", "Extra field selector ANDed with spec.nodeName when listing the node's pods, e.g. \"status.phase=Running\".")
+	excludeOwnerPatterns := fs.StringSlice("exclude-owner-pattern", nil, "Glob patterns, e.g. \"database-*\", for names of owners whose pods are never evicted.")
+	podSelector := fs.String("pod-selector", "", "Label selector restricting which pods are evicted (empty = all pods).")
+	evictionMode := fs.String("eviction-mode", string(driver.EvictionModeAPI), "Who evicts pods: \"api\" (the driver, through the API) or \"taint\" (the cluster, after the driver adds a NoExecute maintenance taint on cordon). The taint deletes every pod that doesn't tolerate it: skip policies and exclusions don't protect pods from it, and PodDisruptionBudgets are not honored.")
+	cordonConfirmTimeout := fs.Duration("cordon-confirm-timeout", 0, "Before reporting drain-started, wait up to this long for the API server's watch cache, which the scheduler reads through, to show the node cordoned (0 = don't wait).")
+	cordonSettleDelay := fs.Duration("cordon-settle-delay", 0, "Delay between cordoning the node and the first eviction, so the scheduler stops placing pods on it first.")
+	evictionStartJitter := fs.Duration("eviction-start-jitter", 0, "Maximum random delay before the background eviction starts, to spread API load across nodes draining at once.")
+	evictionPasses := fs.Int("eviction-passes", 2, "Maximum list+evict sweeps per drain; later sweeps catch pods scheduled onto the node before the cordon took effect.")
+	drainDaemonSets := fs.Bool("drain-daemonsets", false, "Also evict DaemonSet pods, for nodes being torn down for good. They are recreated until the node is deleted, so the drain may only finish with node-deleted.")
+	unmanagedPods := fs.String("unmanaged-pods", string(driver.UnmanagedPodsEvict), "What to do with pods that have no controller: \"evict\" them, \"skip\" them, or evict them only \"if-pdb\" a PodDisruptionBudget covers them.")
+	standaloneReplicaSetsUnmanaged := fs.Bool("standalone-replicasets-unmanaged", false, "Apply --unmanaged-pods to pods of ReplicaSets that no Deployment controls, too.")
+	waitForTerminating := fs.Bool("wait-for-terminating", false, "Don't complete the drain while pods that are being deleted, e.g. held by a finalizer, are still on the node. A drain that times out lists them with their finalizers.")
+	waitForDaemonSetTermination := fs.Bool("wait-for-daemonset-termination", false, "Don't complete the drain while DaemonSet pods that are being deleted are still on the node.")
+	evictionBatchSize := fs.Int("eviction-batch-size", 0, "Evict pods in batches of this many, pausing --eviction-batch-delay between batches (0 = no batching).")
+	evictionBatchDelay := fs.Duration("eviction-batch-delay", 30*time.Second, "Delay between eviction batches when --eviction-batch-size is set.")
+	evictLastTolerating := fs.String("evict-last-tolerating", "", "Taint key; pods that tolerate a taint with this key, e.g. nvidia.com/gpu, are evicted after all other pods.")
+	rollingEviction := fs.Bool("rolling-eviction", false, "Evict each controller's pods on the node one at a time, waiting for every replacement to be Ready on another node before evicting the next.")
+	rollingEvictionTimeout := fs.Duration("rolling-eviction-timeout", 5*time.Minute, "How long --rolling-eviction waits for a Ready replacement before evicting the owner's next pod anyway.")
+	drainFraction := fs.Float64("drain-fraction", 1, "Fraction of the evictable pods, in (0, 1], to evict. Below 1 the drain completes once that fraction, rounded up, is gone and leaves the rest running on the cordoned node, e.g. to test disruption tolerance. Ignored with --eviction-mode=taint.")
+	evictByTier := fs.Bool("evict-by-tier", false, "Evict pods tier by tier, lowest first, by the drain.slm.k8s.io/tier annotation on their Deployment, StatefulSet or standalone ReplicaSet (default tier 0). Each tier starts once the previous tier's evicted pods have left the node.")
+	tierTimeout := fs.Duration("tier-timeout", 5*time.Minute, "How long --evict-by-tier waits for a tier's evicted pods to leave the node before evicting the next tier anyway.")
+	minReadySeconds := fs.Int32("min-ready-seconds", 0, "How long a replacement must have been Ready before --rolling-eviction evicts the owner's next pod.")
+	verifyReschedule := fs.Bool("verify-reschedule", false, "Check that each evicted controller-owned pod gets a replacement scheduled to another node, and record a warning if none is within --verify-reschedule-timeout.")
+	verifyRescheduleTimeout := fs.Duration("verify-reschedule-timeout", 2*time.Minute, "How long --verify-reschedule waits for a replacement pod.")
+	evictionLogSummary := fs.Bool("eviction-log-summary", false, "Log eviction counts once per sweep instead of each pod's outcome; pods whose evictions finally fail are still logged. For nodes with many pods.")
+	reportDrainCondition := fs.Bool("report-drain-condition", false, "Maintain a DrainInProgress condition in the node status with the drain's progress and outcome.")
+	skipCriticalPods := fs.Bool("skip-critical-pods", false, "Never evict pods with the system-node-critical or system-cluster-critical priority class.")
+	drainDisabledAnnotation := fs.String("drain-disabled-annotation", driver.DrainDisabledAnnotation, "Node annotation that, set to \"true\", makes the driver refuse to drain the node. Empty disables the check.")
+	respectSafeToEvict := fs.Bool("respect-safe-to-evict", false, "Never evict pods annotated cluster-autoscaler.kubernetes.io/safe-to-evict=\"false\".")
+	labelBlockedPods := fs.Bool("label-blocked-pods", false, "Label pods still blocking a drain after --label-blocked-pods-after with drain.slm.k8s.io/blocked=true.")
+	labelBlockedPodsAfter := fs.Duration("label-blocked-pods-after", 10*time.Minute, "How long into a drain --label-blocked-pods waits before labeling the pods that remain.")
+	pdbBlockedWarnAfter := fs.Duration("pdb-blocked-warn-after", 5*time.Minute, "Log a warning for each pod whose eviction has been blocked by a PodDisruptionBudget for this long (0 = never).")
+	maxEvictionAttempts := fs.Int("max-eviction-attempts", 1, "Maximum eviction attempts per pod; failed evictions are retried until this cap, after the server's Retry-After hint or with exponential backoff.")
+	maxFleetDisruptions := fs.Int("max-fleet-disruptions", 0, "Maximum pods all drivers in the cluster may have evicted at once; evictions wait for budget (0 = unlimited).")
+	fleetBudgetConfigMap := fs.String("fleet-budget-configmap", "kube-system/kssd-fleet-disruptions", "ConfigMap (<namespace>/<name>) drivers share to enforce --max-fleet-disruptions.")
+	drainLease := fs.Bool("drain-lease", false, "Keep a coordination.k8s.io Lease named kssd-drain-<node> for each active drain, renewed whenever more pods have been evicted and deleted when the drain finishes. A stale Lease marks a stuck drain.")
+	drainLeaseNamespace := fs.String("drain-lease-namespace", "kube-system", "Namespace of the --drain-lease Leases.")
+	postDrainWebhookURL := fs.String("post-drain-webhook-url", "", "URL to POST a JSON summary to when a drain reaches drain-complete. Failed notifications are logged and do not affect the drain.")
+	postDrainWebhookMaintenance := fs.Bool("post-drain-webhook-maintenance-complete", false, "Also notify --post-drain-webhook-url when an uncordon reaches maintenance-complete.")
+	fleetPauseConfigMap := fs.String("fleet-pause-configmap", "", "ConfigMap (<namespace>/<name>) that pauses eviction on every driver while its \"paused\" key is \"true\", e.g. kube-system/kssd-fleet-pause. Empty disables the fleet pause.")
+	fleetDisruptionTTL := fs.Duration("fleet-disruption-ttl", 10*time.Minute, "How long an evicted pod holds fleet budget if its driver never releases it.")
+	minPodAge := fs.Duration("min-pod-age", 0, "Defer evicting pods that started less than this long ago until they age in (0 = evict regardless of age).")
+	logEvictionEvents := fs.Bool("log-eviction-events", false, "Watch pod Events in the namespaces of evicted pods during a drain and log those about evicted pods and FailedScheduling of their replacements.")
+	watchNode := fs.Bool("watch-node", false, "Watch the node during a drain to detect it being uncordoned externally.")
+	externalUncordonAction := fs.String("external-uncordon-action", string(driver.ExternalUncordonWarn), "Action when --watch-node sees the node uncordoned mid-drain: \"warn\", \"recordon\" or \"abort\".")
+	configMap := fs.String("config-configmap", "", "ConfigMap (<namespace>/<name>) to load eviction defaults from. Flags set on the command line take precedence.")
+	evictionStrategy := fs.String("eviction-strategy", string(driver.EvictionStrategyEviction), "How to remove ReplicaSet-owned pods: \"eviction\" (Eviction API, respects PDBs) or \"delete\" (delete and let the controller reschedule).")
 
 	fs = sharedFlagSets.FlagSet("other")
 	featureGate := featuregate.NewFeatureGate()
@@ -122,6 +232,7 @@ func NewCommand() *cobra.Command {
 				return fmt.Errorf("create out-of-cluster config: %w", err)
 			}
 		}
+		config.WarningHandlerWithContext = driver.WarningHandler{}
 		config.QPS = *kubeAPIQPS
 		config.Burst = int(*kubeAPIBurst)
 
@@ -132,6 +243,26 @@ func NewCommand() *cobra.Command {
 		return nil
 	}
 
+	// transitions builds the LifecycleTransitions from the flags.
+	transitions := func() (drain, uncordon *lifecycleapi.LifecycleTransition, err error) {
+		drainSLAFlag, drainSLAValue := "drain-sla", *drainSLA
+		if drainSLAValue == 0 {
+			drainSLAFlag, drainSLAValue = "sla", *sla
+		}
+		uncordonSLAFlag, uncordonSLAValue := "uncordon-sla", *uncordonSLA
+		if uncordonSLAValue == 0 {
+			uncordonSLAFlag, uncordonSLAValue = "sla", *sla
+		}
+		if drainSLAValue, err = normalizeSLA(logger, drainSLAFlag, drainSLAValue, *evictionTimeout); err != nil {
+			return nil, nil, err
+		}
+		if uncordonSLAValue, err = normalizeSLA(logger, uncordonSLAFlag, uncordonSLAValue, 0); err != nil {
+			return nil, nil, err
+		}
+		drain, uncordon = newTransitions(*driverName, drainSLAValue, uncordonSLAValue)
+		return drain, uncordon, nil
+	}
+
 	// kubelet-plugin subcommand
 	kubeletPlugin := &cobra.Command{
 		Use:   "kubelet-plugin",
@@ -143,9 +274,22 @@ func NewCommand() *cobra.Command {
 	fs = pluginFlagSets.FlagSet("kubelet")
 	kubeletRegistryDir := fs.String("plugin-registration-path", DefaultKubeletRegistryDir, "kubelet plugin registration directory")
 	kubeletPluginsDir := fs.String("datadir", DefaultKubeletPluginsDir, "kubelet plugins base directory")
+	slmSocketName := fs.String("slm-socket-name", "slm.sock", "File name of the SLM socket in the driver's --datadir subdirectory.")
+	regSocketName := fs.String("registration-socket-name", "", "File name of the registration socket in --plugin-registration-path (default \"<driver-name>-reg.sock\").")
+	supportedVersions := fs.StringSlice("supported-versions", []string{slmpbv1alpha1.SLMPluginService}, "SLM plugin API versions advertised to the kubelet during registration.")
 	fs = pluginFlagSets.FlagSet("SLM")
 	nodeName := fs.String("node-name", "", "Name of this node (required).")
-	sla := fs.Duration("sla", 5*time.Minute, "SLA duration for completing the drain.")
+	activeNodeSelector := fs.String("active-node-selector", "", "Label selector the node must match, checked once at startup. On a node that does not match, the driver neither publishes transitions nor registers with the kubelet and just waits to be stopped. Empty matches every node.")
+	slmListenTCP := fs.String("slm-listen-tcp", "", "Also serve the SLM gRPC API on this TCP address (e.g. \"127.0.0.1:9090\") so a test harness can call it without a kubelet. Unauthenticated; for testing only.")
+	enableReflection := fs.Bool("enable-reflection", false, "Register gRPC server reflection on the SLM socket so grpcurl can list and call methods without the proto files. For debugging.")
+	enableOTel := fs.Bool("enable-otel", false, "Export a trace span per drain and uncordon, with an event per eviction, over OTLP/gRPC. The exporter is configured with the standard OTEL_* environment variables, e.g. OTEL_EXPORTER_OTLP_ENDPOINT.")
+	grpcMaxRecvMsgSize := fs.Int("grpc-max-recv-msg-size", 4*1024*1024, "Largest gRPC message, in bytes, the SLM server accepts.")
+	grpcMaxSendMsgSize := fs.Int("grpc-max-send-msg-size", math.MaxInt32, "Largest gRPC message, in bytes, the SLM server sends, e.g. a PreviewDrain response on a node with many pods.")
+	drainOnShutdown := fs.Bool("drain-on-shutdown", false, "On SIGTERM, if the node is shutting down, cordon it and evict its pods before exiting, for at most --shutdown-timeout. The node counts as shutting down if it is already cordoned or has the node.kubernetes.io/out-of-service or node.cloudprovider.kubernetes.io/shutdown taint; any other SIGTERM, such as a driver upgrade, evicts nothing. Lengthens shutdown.")
+	shutdownTimeout := fs.Duration("shutdown-timeout", 30*time.Second, "How long --drain-on-shutdown may spend evicting.")
+	httpEndpoint := fs.String("http-endpoint", "", "TCP address (e.g. \":8080\") to serve /metrics and /debug/drains on. Empty disables the HTTP server.")
+	transitionResyncInterval := fs.Duration("transition-resync-interval", 0, "How often to publish the LifecycleTransitions again, restoring them if they were deleted (0 = only at startup).")
+	transitionUpdate := fs.String("transition-update-policy", string(transitionUpdateAlways), "What to do with LifecycleTransitions that already exist at startup: \"always\" overwrite them, update them only \"if-owned\" by this driver, or \"never\" touch them.")
 	fs = kubeletPlugin.Flags()
 	for _, f := range pluginFlagSets.FlagSets {
 		fs.AddFlagSet(f)
@@ -155,103 +299,409 @@ func NewCommand() *cobra.Command {
 		if *nodeName == "" {
 			return errors.New("--node-name is required")
 		}
+		if *regSocketName == "" {
+			*regSocketName = *driverName + "-reg.sock"
+		}
+		for flag, name := range map[string]string{"slm-socket-name": *slmSocketName, "registration-socket-name": *regSocketName} {
+			if name != filepath.Base(name) || name == "." || name == ".." {
+				return fmt.Errorf("invalid --%s %q: must be a file name, not a path", flag, name)
+			}
+		}
+		if len(*supportedVersions) == 0 || slices.Contains(*supportedVersions, "") {
+			return errors.New("--supported-versions must list at least one non-empty version")
+		}
+		for flag, size := range map[string]int{"grpc-max-recv-msg-size": *grpcMaxRecvMsgSize, "grpc-max-send-msg-size": *grpcMaxSendMsgSize} {
+			if size <= 0 {
+				return fmt.Errorf("invalid --%s %d: must be positive", flag, size)
+			}
+		}
 
+		// Check the socket directories before publishing anything, so a
+		// bad mount fails fast instead of leaving transitions behind
+		// with no driver serving them.
 		datadir := path.Join(*kubeletPluginsDir, *driverName)
-		if err := os.MkdirAll(filepath.Dir(datadir), 0750); err != nil {
-			return fmt.Errorf("create socket directory: %w", err)
+		if err := checkWritableDir(datadir); err != nil {
+			return fmt.Errorf("invalid --datadir: %w", err)
+		}
+		if err := checkWritableDir(*kubeletRegistryDir); err != nil {
+			return fmt.Errorf("invalid --plugin-registration-path: %w", err)
 		}
 
 		ctx := cmd.Context()
 
+		// Flags set on the command line win over the ConfigMap, both now
+		// and on every SIGHUP reload.
+		pinned := sets.New[string]()
+		cmd.Flags().Visit(func(f *pflag.Flag) {
+			pinned.Insert(f.Name)
+		})
+
+		if *configMap != "" {
+			if err := applyConfigMap(ctx, clientset, *configMap, cmd.Flags(), pinned); err != nil {
+				return err
+			}
+			logger.Info("Loaded eviction defaults from ConfigMap", "configMap", *configMap)
+		}
+
+		switch driver.EvictionStrategy(*evictionStrategy) {
+		case driver.EvictionStrategyEviction, driver.EvictionStrategyDelete:
+		default:
+			return fmt.Errorf("invalid --eviction-strategy %q: must be %q or %q", *evictionStrategy, driver.EvictionStrategyEviction, driver.EvictionStrategyDelete)
+		}
+		for _, pattern := range *excludeOwnerPatterns {
+			if err := driver.ValidateOwnerPattern(pattern); err != nil {
+				return fmt.Errorf("invalid --exclude-owner-pattern %q: %w", pattern, err)
+			}
+		}
+		switch driver.EvictionMode(*evictionMode) {
+		case driver.EvictionModeAPI, driver.EvictionModeTaint:
+		default:
+			return fmt.Errorf("invalid --eviction-mode %q: must be %q or %q", *evictionMode, driver.EvictionModeAPI, driver.EvictionModeTaint)
+		}
+		if driver.EvictionMode(*evictionMode) == driver.EvictionModeTaint {
+			logger.Info("WARNING: --eviction-mode=taint deletes every pod not tolerating the maintenance taint, ignoring exclusions and PodDisruptionBudgets")
+		}
+		if *drainDisabledAnnotation != "" {
+			if errs := validation.IsQualifiedName(*drainDisabledAnnotation); len(errs) > 0 {
+				return fmt.Errorf("invalid --drain-disabled-annotation %q: %s", *drainDisabledAnnotation, strings.Join(errs, "; "))
+			}
+		}
+		switch driver.UnmanagedPodPolicy(*unmanagedPods) {
+		case driver.UnmanagedPodsEvict, driver.UnmanagedPodsSkip, driver.UnmanagedPodsIfPDB:
+		default:
+			return fmt.Errorf("invalid --unmanaged-pods %q: must be %q, %q or %q", *unmanagedPods,
+				driver.UnmanagedPodsEvict, driver.UnmanagedPodsSkip, driver.UnmanagedPodsIfPDB)
+		}
+		switch driver.NodeMutationMethod(*nodeMutationMethod) {
+		case driver.NodeMutationPatch, driver.NodeMutationUpdate:
+		default:
+			return fmt.Errorf("invalid --node-mutation-method %q: must be %q or %q", *nodeMutationMethod, driver.NodeMutationPatch, driver.NodeMutationUpdate)
+		}
+		switch driver.ExternalUncordonAction(*externalUncordonAction) {
+		case driver.ExternalUncordonWarn, driver.ExternalUncordonRecordon, driver.ExternalUncordonAbort:
+		default:
+			return fmt.Errorf("invalid --external-uncordon-action %q: must be %q, %q or %q", *externalUncordonAction,
+				driver.ExternalUncordonWarn, driver.ExternalUncordonRecordon, driver.ExternalUncordonAbort)
+		}
+		if *maxFleetDisruptions > 0 {
+			if ns, name, ok := strings.Cut(*fleetBudgetConfigMap, "/"); !ok || ns == "" || name == "" {
+				return fmt.Errorf("invalid --fleet-budget-configmap %q: must be <namespace>/<name>", *fleetBudgetConfigMap)
+			}
+			if *fleetDisruptionTTL <= 0 {
+				return fmt.Errorf("invalid --fleet-disruption-ttl %s: must be positive", *fleetDisruptionTTL)
+			}
+		}
+		if *rollingEviction && *rollingEvictionTimeout <= 0 {
+			return fmt.Errorf("invalid --rolling-eviction-timeout %s: must be positive", *rollingEvictionTimeout)
+		}
+		if *cordonConfirmTimeout < 0 {
+			return fmt.Errorf("invalid --cordon-confirm-timeout %s: must not be negative", *cordonConfirmTimeout)
+		}
+		if *maxConcurrentNodeOps < 0 {
+			return fmt.Errorf("invalid --max-concurrent-node-ops %d: must not be negative", *maxConcurrentNodeOps)
+		}
+		if *podListAttempts < 1 {
+			return fmt.Errorf("invalid --pod-list-attempts %d: must be at least 1", *podListAttempts)
+		}
+		if *nodeUpdateAttempts < 1 {
+			return fmt.Errorf("invalid --node-update-attempts %d: must be at least 1", *nodeUpdateAttempts)
+		}
+		if *fleetPauseConfigMap != "" {
+			if ns, name, ok := strings.Cut(*fleetPauseConfigMap, "/"); !ok || ns == "" || name == "" {
+				return fmt.Errorf("invalid --fleet-pause-configmap %q: must be <namespace>/<name>", *fleetPauseConfigMap)
+			}
+		}
+		if *transitionResyncInterval < 0 {
+			return fmt.Errorf("invalid --transition-resync-interval %s: must not be negative", *transitionResyncInterval)
+		}
+		if *drainOnShutdown && *shutdownTimeout <= 0 {
+			return fmt.Errorf("invalid --shutdown-timeout %s: must be positive", *shutdownTimeout)
+		}
+		if *labelBlockedPods && *labelBlockedPodsAfter <= 0 {
+			return fmt.Errorf("invalid --label-blocked-pods-after %s: must be positive", *labelBlockedPodsAfter)
+		}
+		if *drainFraction <= 0 || *drainFraction > 1 {
+			return fmt.Errorf("invalid --drain-fraction %v: must be greater than 0 and at most 1", *drainFraction)
+		}
+		if *evictByTier && *tierTimeout <= 0 {
+			return fmt.Errorf("invalid --tier-timeout %s: must be positive", *tierTimeout)
+		}
+		if *minReadySeconds < 0 {
+			return fmt.Errorf("invalid --min-ready-seconds %d: must not be negative", *minReadySeconds)
+		}
+		if *verifyReschedule && *verifyRescheduleTimeout <= 0 {
+			return fmt.Errorf("invalid --verify-reschedule-timeout %s: must be positive", *verifyRescheduleTimeout)
+		}
+		if *drainTimeout < 0 {
+			return fmt.Errorf("invalid --drain-timeout %s: must not be negative", *drainTimeout)
+		}
+		if *evictionBatchSize < 0 {
+			return fmt.Errorf("invalid --eviction-batch-size %d: must not be negative", *evictionBatchSize)
+		}
+		if *drainLease {
+			if errs := validation.IsDNS1123Label(*drainLeaseNamespace); len(errs) > 0 {
+				return fmt.Errorf("invalid --drain-lease-namespace %q: %s", *drainLeaseNamespace, strings.Join(errs, "; "))
+			}
+		}
+		if *postDrainWebhookURL != "" {
+			if u, err := url.Parse(*postDrainWebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("invalid --post-drain-webhook-url %q: must be an http or https URL", *postDrainWebhookURL)
+			}
+		}
+		updatePolicy := transitionUpdatePolicy(*transitionUpdate)
+		switch updatePolicy {
+		case transitionUpdateAlways, transitionUpdateIfOwned, transitionUpdateNever:
+		default:
+			return fmt.Errorf("invalid --transition-update-policy %q: must be %q, %q or %q", *transitionUpdate,
+				transitionUpdateAlways, transitionUpdateIfOwned, transitionUpdateNever)
+		}
+		drainTransition, uncordonTransition, err := transitions()
+		if err != nil {
+			return err
+		}
+
+		selector, err := parsePodSelector(*podSelector)
+		if err != nil {
+			return err
+		}
+
+		nodeSelector, err := labels.Parse(*activeNodeSelector)
+		if err != nil {
+			return fmt.Errorf("invalid --active-node-selector: %w", err)
+		}
+
+		var fieldSelector fields.Selector
+		if *podFieldSelector != "" {
+			fieldSelector, err = driver.ParsePodFieldSelector(*podFieldSelector)
+			if err != nil {
+				return fmt.Errorf("invalid --pod-field-selector: %w", err)
+			}
+		}
+
+		driverConfig := driver.Config{
+			EvictionTimeout:                     *evictionTimeout,
+			GracePeriod:                         *gracePeriod,
+			KeepPreStopGracePeriod:              *keepPreStopGracePeriod,
+			NodeOpTimeout:                       *nodeOpTimeout,
+			MaxConcurrentNodeOps:                *maxConcurrentNodeOps,
+			NodeUpdateAttempts:                  *nodeUpdateAttempts,
+			NodeMutationMethod:                  driver.NodeMutationMethod(*nodeMutationMethod),
+			PodListAttempts:                     *podListAttempts,
+			DrainTimeout:                        *drainTimeout,
+			EnforceEventSLA:                     *enforceEventSLA,
+			EvictionStrategy:                    driver.EvictionStrategy(*evictionStrategy),
+			EvictionMode:                        driver.EvictionMode(*evictionMode),
+			ExcludeOwnerPatterns:                *excludeOwnerPatterns,
+			WatchPods:                           *watchPods,
+			UncordonAfterDrain:                  *uncordonAfterDrain,
+			ExcludedNamespaces:                  sets.New(*excludedNamespaces...),
+			PodSelector:                         selector,
+			EvictionStartJitter:                 *evictionStartJitter,
+			CordonSettleDelay:                   *cordonSettleDelay,
+			CordonConfirmTimeout:                *cordonConfirmTimeout,
+			EvictionPasses:                      *evictionPasses,
+			PodFieldSelector:                    fieldSelector,
+			WatchNode:                           *watchNode,
+			LogEvictionEvents:                   *logEvictionEvents,
+			MinPodAge:                           *minPodAge,
+			RespectSafeToEvict:                  *respectSafeToEvict,
+			DrainDisabledAnnotation:             *drainDisabledAnnotation,
+			SkipCriticalPods:                    *skipCriticalPods,
+			DrainDaemonSets:                     *drainDaemonSets,
+			UnmanagedPods:                       driver.UnmanagedPodPolicy(*unmanagedPods),
+			StandaloneReplicaSetsUnmanaged:      *standaloneReplicaSetsUnmanaged,
+			WaitForDaemonSetTermination:         *waitForDaemonSetTermination,
+			WaitForTerminating:                  *waitForTerminating,
+			ReportDrainCondition:                *reportDrainCondition,
+			EvictionBatchSize:                   *evictionBatchSize,
+			EvictionBatchDelay:                  *evictionBatchDelay,
+			EvictionLogSummary:                  *evictionLogSummary,
+			EvictLastTolerating:                 *evictLastTolerating,
+			RollingEviction:                     *rollingEviction,
+			RollingEvictionTimeout:              *rollingEvictionTimeout,
+			DrainFraction:                       *drainFraction,
+			EvictByTier:                         *evictByTier,
+			TierTimeout:                         *tierTimeout,
+			MinReadySeconds:                     *minReadySeconds,
+			VerifyRescheduleTimeout:             enabledDuration(*verifyReschedule, *verifyRescheduleTimeout),
+			PDBBlockedWarnAfter:                 *pdbBlockedWarnAfter,
+			LabelBlockedPodsAfter:               enabledDuration(*labelBlockedPods, *labelBlockedPodsAfter),
+			MaxEvictionAttempts:                 *maxEvictionAttempts,
+			MaxFleetDisruptions:                 *maxFleetDisruptions,
+			FleetBudgetConfigMap:                *fleetBudgetConfigMap,
+			FleetDisruptionTTL:                  *fleetDisruptionTTL,
+			FleetPauseConfigMap:                 *fleetPauseConfigMap,
+			DrainLease:                          *drainLease,
+			DrainLeaseNamespace:                 *drainLeaseNamespace,
+			PostDrainWebhookURL:                 *postDrainWebhookURL,
+			PostDrainWebhookMaintenanceComplete: *postDrainWebhookMaintenance,
+			ExternalUncordonAction:              driver.ExternalUncordonAction(*externalUncordonAction),
+		}
+
+		active, err := nodeMatches(ctx, clientset, *nodeName, nodeSelector)
+		if err != nil {
+			return err
+		}
+		if !active {
+			logger.Info("Node does not match --active-node-selector, staying inactive", "node", *nodeName, "selector", nodeSelector.String())
+			sigc := make(chan os.Signal, 1)
+			signal.Notify(sigc, os.Interrupt, syscall.SIGTERM)
+			logger.Info("Received signal, shutting down", "signal", <-sigc)
+			return nil
+		}
+
+		if err := checkLifecycleAPI(clientset); err != nil {
+			return err
+		}
+
 		// Create LifecycleTransitions
-		//
-		// The drain driver publishes two cluster-wide transitions,
-		// usable on all nodes, as defined by the KEP:
-		//   1. drain-started → drain-complete    (cordon + evict)
-		//   2. uncordoning   → maintenance-complete (uncordon)
-		allNodes := true
-		slaDuration := metav1.Duration{Duration: *sla}
-
-		drainTransition := &lifecycleapi.LifecycleTransition{
-			ObjectMeta: metav1.ObjectMeta{Name: DrainTransitionName},
-			Spec: lifecycleapi.LifecycleTransitionSpec{
-				Start:    driver.DrainStarted,
-				End:      driver.DrainComplete,
-				AllNodes: &allNodes,
-				Driver:   *driverName,
-				Sla:      &slaDuration,
-			},
-		}
-		if err := createOrUpdateTransition(ctx, clientset, drainTransition); err != nil {
+		if err := publishTransition(ctx, clientset, drainTransition, updatePolicy); err != nil {
 			return fmt.Errorf("create drain LifecycleTransition: %w", err)
 		}
 		logger.Info("Published LifecycleTransition", "name", drainTransition.Name)
 
-		uncordonTransition := &lifecycleapi.LifecycleTransition{
-			ObjectMeta: metav1.ObjectMeta{Name: MaintenanceCompleteTransitionName},
-			Spec: lifecycleapi.LifecycleTransitionSpec{
-				Start:    driver.Uncordoning,
-				End:      driver.MaintenanceComplete,
-				AllNodes: &allNodes,
-				Driver:   *driverName,
-				Sla:      &slaDuration,
-			},
-		}
-		if err := createOrUpdateTransition(ctx, clientset, uncordonTransition); err != nil {
+		if err := publishTransition(ctx, clientset, uncordonTransition, updatePolicy); err != nil {
 			return fmt.Errorf("create uncordon LifecycleTransition: %w", err)
 		}
 		logger.Info("Published LifecycleTransition", "name", uncordonTransition.Name)
 
+		bgCtx, stopBackground := context.WithCancel(ctx)
+		defer stopBackground()
+		if *transitionResyncInterval > 0 {
+			go resyncTransitions(bgCtx, clientset, *transitionResyncInterval, updatePolicy, drainTransition, uncordonTransition)
+		}
+
+		shutdownOTel := func(context.Context) error { return nil }
+		if *enableOTel {
+			shutdownOTel, err = setupOTel(ctx, *driverName)
+			if err != nil {
+				return err
+			}
+			logger.Info("Exporting drain traces with OpenTelemetry")
+		}
+
 		// Start gRPC server
-		slmEndpoint := path.Join(datadir, "slm.sock")
-		slmListener, err := listen(slmEndpoint)
+		slmEndpoint := path.Join(datadir, *slmSocketName)
+		drainService := driver.NewDrainService(clientset, *nodeName, driverConfig)
+		if err := drainService.WatchFleetPause(bgCtx); err != nil {
+			return err
+		}
+		slmServer, err := serveSLM(logger, slmEndpoint, drainService, *enableReflection,
+			grpc.MaxRecvMsgSize(*grpcMaxRecvMsgSize),
+			grpc.MaxSendMsgSize(*grpcMaxSendMsgSize),
+		)
 		if err != nil {
-			return fmt.Errorf("listen SLM socket: %w", err)
+			return err
 		}
-		slmServer := grpc.NewServer()
-		slmpbv1alpha1.RegisterSLMPluginServer(slmServer, driver.NewDrainService(clientset, *nodeName, *evictionTimeout, *gracePeriod))
-		go func() {
-			logger.Info("SLM gRPC server started", "endpoint", slmEndpoint)
-			if err := slmServer.Serve(slmListener); err != nil {
-				logger.Error(err, "SLM gRPC server failed")
+		if *slmListenTCP != "" {
+			tcpListener, err := net.Listen("tcp", *slmListenTCP)
+			if err != nil {
+				slmServer.Stop()
+				return fmt.Errorf("listen SLM TCP address: %w", err)
 			}
-		}()
+			go func() {
+				logger.Info("WARNING: SLM gRPC server listening on TCP without authentication, for testing only", "address", tcpListener.Addr().String())
+				logServeExit(logger, "SLM gRPC server on TCP", slmServer.Serve(tcpListener))
+			}()
+		}
+
+		// Register metrics before the kubelet can report the
+		// registration status.
+		if *httpEndpoint != "" {
+			metrics.Register()
+		}
 
 		// Start registration server
-		regSocket := filepath.Join(*kubeletRegistryDir, *driverName+"-reg.sock")
-		regListener, err := listen(regSocket)
+		regSocket := filepath.Join(*kubeletRegistryDir, *regSocketName)
+		regServer, err := serveRegistration(logger, regSocket, *driverName, slmEndpoint, *supportedVersions)
 		if err != nil {
 			slmServer.Stop()
-			return fmt.Errorf("listen registration socket: %w", err)
+			return err
 		}
-		regServer := grpc.NewServer()
-		registerapi.RegisterRegistrationServer(regServer, &registrationService{
-			driverName:        *driverName,
-			endpoint:          slmEndpoint,
-			supportedVersions: []string{slmpbv1alpha1.SLMPluginService},
-		})
-		go func() {
-			logger.Info("Registration server started", "socket", regSocket)
-			if err := regServer.Serve(regListener); err != nil {
-				logger.Error(err, "Registration gRPC server failed")
+
+		var httpServer *http.Server
+		if *httpEndpoint != "" {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", legacyregistry.Handler())
+			mux.Handle("/debug/drains", debugDrainsHandler(drainService))
+			httpServer, err = serveHTTP(logger, *httpEndpoint, mux)
+			if err != nil {
+				regServer.Stop()
+				slmServer.Stop()
+				return err
 			}
-		}()
+		}
 
 		logger.Info("Drain driver started",
 			"driverName", *driverName,
+			"version", version(),
 			"nodeName", *nodeName,
 			"slmEndpoint", slmEndpoint,
 			"registrationSocket", regSocket,
 		)
 
+		// SIGUSR1 toggles pausing evictions.
+		usr1 := make(chan os.Signal, 1)
+		signal.Notify(usr1, syscall.SIGUSR1)
+		go func() {
+			for range usr1 {
+				paused := drainService.TogglePaused(logger)
+				logger.Info("Received SIGUSR1, toggled drain pause", "paused", paused)
+			}
+		}()
+
+		// SIGHUP reloads the ConfigMap-backed tunables.
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go func() {
+			for range hup {
+				if *configMap == "" {
+					logger.Info("Received SIGHUP but --config-configmap is not set, nothing to reload")
+					continue
+				}
+				if err := applyConfigMap(ctx, clientset, *configMap, cmd.Flags(), pinned); err != nil {
+					logger.Error(err, "Reload failed, keeping current configuration")
+					continue
+				}
+				selector, err := parsePodSelector(*podSelector)
+				if err != nil {
+					logger.Error(err, "Reload failed, keeping current configuration")
+					continue
+				}
+				drainService.Reload(logger, driver.Config{
+					EvictionTimeout:    *evictionTimeout,
+					GracePeriod:        *gracePeriod,
+					ExcludedNamespaces: sets.New(*excludedNamespaces...),
+					PodSelector:        selector,
+				})
+			}
+		}()
+
 		// Wait for shutdown
 		sigc := make(chan os.Signal, 1)
 		signal.Notify(sigc, os.Interrupt, syscall.SIGTERM)
 		sig := <-sigc
 		logger.Info("Received signal, shutting down", "signal", sig)
 
+		if httpServer != nil {
+			_ = httpServer.Shutdown(context.Background())
+		}
 		regServer.GracefulStop()
 		slmServer.GracefulStop()
+		if *drainOnShutdown && sig == syscall.SIGTERM {
+			shutdownCtx, cancel := context.WithTimeout(klog.NewContext(context.Background(), logger), *shutdownTimeout)
+			summary, evicted := drainService.DrainOnShutdown(shutdownCtx)
+			cancel()
+			if evicted {
+				logger.Info("Shutdown eviction done", "node", *nodeName, "timeout", *shutdownTimeout, "total", summary.Total, "evicted", summary.Evicted, "failed", summary.Failed)
+			}
+		}
+		for _, socket := range []string{regSocket, slmEndpoint} {
+			removeSocket(logger, socket)
+		}
+		if err := shutdownOTel(context.Background()); err != nil {
+			logger.Error(err, "Failed to flush OpenTelemetry traces")
+		}
 
 		// The kubelet's SLM plugin manager handles cleanup of
 		// node-scoped transitions on driver deregistration, but
@@ -261,6 +711,9 @@ func NewCommand() *cobra.Command {
 		return nil
 	}
 	cmd.AddCommand(kubeletPlugin)
+	cmd.AddCommand(newSelfTestCommand())
+	cmd.AddCommand(newValidateTransitionsCommand(func() kubernetes.Interface { return clientset }, transitions))
+	cmd.AddCommand(newDeregisterCommand(driverName, func() kubernetes.Interface { return clientset }, transitions))
 
 	cols, _, _ := term.TerminalSize(cmd.OutOrStdout())
 	cliflag.SetUsageAndHelpFunc(cmd, sharedFlagSets, cols)
@@ -269,21 +722,277 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-// createOrUpdateTransition creates the LifecycleTransition or updates it if
-// it already exists.
-func createOrUpdateTransition(ctx context.Context, cs kubernetes.Interface, lt *lifecycleapi.LifecycleTransition) error {
-	_, err := cs.LifecycleV1alpha1().LifecycleTransitions().Create(ctx, lt, metav1.CreateOptions{})
-	if apierrors.IsAlreadyExists(err) {
-		existing, getErr := cs.LifecycleV1alpha1().LifecycleTransitions().Get(ctx, lt.Name, metav1.GetOptions{})
-		if getErr != nil {
-			return getErr
+// normalizeSLA validates the SLA set with --<flag> and rounds it to whole
+// seconds, the granularity the transition's SLA is meaningful at. An SLA
+// that cannot fit even a single pod eviction is rejected, since every
+// drain would miss it; pass zero evictionTimeout for transitions that
+// evict nothing.
+func normalizeSLA(logger klog.Logger, flag string, sla, evictionTimeout time.Duration) (time.Duration, error) {
+	if sla <= 0 {
+		return 0, fmt.Errorf("invalid --%s %s: must be positive", flag, sla)
+	}
+	rounded := max(sla.Round(time.Second), time.Second)
+	if rounded < evictionTimeout {
+		return 0, fmt.Errorf("invalid --%s %s: shorter than --eviction-timeout %s, so a drain could never meet it", flag, rounded, evictionTimeout)
+	}
+	if rounded != sla {
+		logger.Info("Rounded SLA to whole seconds", "flag", flag, "sla", rounded)
+	}
+	return rounded, nil
+}
+
+// newTransitions returns the two cluster-wide transitions the driver
+// publishes, usable on all nodes, as defined by the KEP:
+//  1. drain-started → drain-complete    (cordon + evict)
+//  2. uncordoning   → maintenance-complete (uncordon)
+func newTransitions(driverName string, drainSLA, uncordonSLA time.Duration) (drain, uncordon *lifecycleapi.LifecycleTransition) {
+	allNodes := true
+	ownerAnnotations := map[string]string{TransitionOwnerAnnotation: driverName}
+	drain = &lifecycleapi.LifecycleTransition{
+		ObjectMeta: metav1.ObjectMeta{Name: DrainTransitionName, Annotations: ownerAnnotations},
+		Spec: lifecycleapi.LifecycleTransitionSpec{
+			Start:    driver.DrainStarted,
+			End:      driver.DrainComplete,
+			AllNodes: &allNodes,
+			Driver:   driverName,
+			Sla:      &metav1.Duration{Duration: drainSLA},
+		},
+	}
+	uncordon = &lifecycleapi.LifecycleTransition{
+		ObjectMeta: metav1.ObjectMeta{Name: MaintenanceCompleteTransitionName, Annotations: maps.Clone(ownerAnnotations)},
+		Spec: lifecycleapi.LifecycleTransitionSpec{
+			Start:    driver.Uncordoning,
+			End:      driver.MaintenanceComplete,
+			AllNodes: &allNodes,
+			Driver:   driverName,
+			Sla:      &metav1.Duration{Duration: uncordonSLA},
+		},
+	}
+	return drain, uncordon
+}
+
+// publishTransition calls createOrUpdateTransition until it succeeds,
+// retrying transient API errors with exponential backoff for up to
+// transitionPublishTimeout. Permanent errors are returned immediately.
+func publishTransition(ctx context.Context, cs kubernetes.Interface, lt *lifecycleapi.LifecycleTransition, policy transitionUpdatePolicy) error {
+	logger := klog.FromContext(ctx)
+	ctx, cancel := context.WithTimeout(ctx, transitionPublishTimeout)
+	defer cancel()
+
+	delay := transitionPublishBackoff.DelayFunc()
+	for {
+		err := createOrUpdateTransition(ctx, cs, lt, policy)
+		if err == nil || isPermanentAPIError(err) {
+			return err
+		}
+		backoff := delay()
+		logger.Info("Publishing LifecycleTransition failed, retrying", "name", lt.Name, "retryAfter", backoff, "err", err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gave up after %s: %w", transitionPublishTimeout, err)
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// resyncTransitions calls createOrUpdateTransition for each of lts every
+// interval until ctx is done, so transitions deleted while the driver runs
+// are restored. Failures are logged and retried on the next tick.
+func resyncTransitions(ctx context.Context, cs kubernetes.Interface, interval time.Duration, policy transitionUpdatePolicy, lts ...*lifecycleapi.LifecycleTransition) {
+	logger := klog.FromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, lt := range lts {
+			if err := createOrUpdateTransition(ctx, cs, lt, policy); err != nil {
+				logger.Error(err, "Failed to resync LifecycleTransition", "name", lt.Name)
+				continue
+			}
+			logger.V(4).Info("Resynced LifecycleTransition", "name", lt.Name)
+		}
+	}
+}
+
+// checkLifecycleAPI verifies that the API server serves the lifecycle
+// resources the driver needs, so a cluster without the alpha API fails
+// with instructions rather than an error from the first API call.
+func checkLifecycleAPI(cs kubernetes.Interface) error {
+	gv := lifecycleapi.SchemeGroupVersion.String()
+	resources, err := cs.Discovery().ServerResourcesForGroupVersion(gv)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("the API server does not serve %s: enable the SpecializedLifecycleManagement feature gate and --runtime-config=%s=true", gv, gv)
+	}
+	if err != nil {
+		return fmt.Errorf("discover %s: %w", gv, err)
+	}
+	served := sets.New[string]()
+	for _, r := range resources.APIResources {
+		served.Insert(r.Name)
+	}
+	for _, name := range []string{"lifecycletransitions", "lifecycleevents"} {
+		if !served.Has(name) {
+			return fmt.Errorf("the API server serves %s but not %s: check that the SpecializedLifecycleManagement feature gate is enabled", gv, name)
 		}
-		existing.Spec = lt.Spec
-		_, err = cs.LifecycleV1alpha1().LifecycleTransitions().Update(ctx, existing, metav1.UpdateOptions{})
 	}
+	return nil
+}
+
+// isPermanentAPIError reports whether err will not go away on retry, such
+// as a validation failure or missing RBAC.
+func isPermanentAPIError(err error) bool {
+	return apierrors.IsInvalid(err) ||
+		apierrors.IsBadRequest(err) ||
+		apierrors.IsForbidden(err) ||
+		apierrors.IsUnauthorized(err) ||
+		apierrors.IsMethodNotSupported(err) ||
+		apierrors.IsNotAcceptable(err)
+}
+
+// parsePodSelector parses the --pod-selector value. An empty value selects
+// every pod and yields a nil selector.
+func parsePodSelector(s string) (labels.Selector, error) {
+	if s == "" {
+		return nil, nil
+	}
+	selector, err := labels.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --pod-selector: %w", err)
+	}
+	return selector, nil
+}
+
+// nodeMatches reports whether the labels of the named node match
+// selector, for --active-node-selector.
+func nodeMatches(ctx context.Context, cs kubernetes.Interface, nodeName string, selector labels.Selector) (bool, error) {
+	if selector.Empty() {
+		return true, nil
+	}
+	node, err := cs.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("get node %s for --active-node-selector: %w", nodeName, err)
+	}
+	return selector.Matches(labels.Set(node.Labels)), nil
+}
+
+// createOrUpdateTransition creates the LifecycleTransition or, if it
+// already exists, updates it as allowed by policy.
+func createOrUpdateTransition(ctx context.Context, cs kubernetes.Interface, lt *lifecycleapi.LifecycleTransition, policy transitionUpdatePolicy) error {
+	_, err := cs.LifecycleV1alpha1().LifecycleTransitions().Create(ctx, lt, metav1.CreateOptions{})
+	if !apierrors.IsAlreadyExists(err) || policy == transitionUpdateNever {
+		return err
+	}
+	existing, err := cs.LifecycleV1alpha1().LifecycleTransitions().Get(ctx, lt.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	owner := lt.Annotations[TransitionOwnerAnnotation]
+	if policy == transitionUpdateIfOwned && existing.Annotations[TransitionOwnerAnnotation] != owner {
+		klog.FromContext(ctx).Info("Leaving LifecycleTransition not owned by this driver untouched", "name", lt.Name)
+		return nil
+	}
+	existing.Spec = lt.Spec
+	metav1.SetMetaDataAnnotation(&existing.ObjectMeta, TransitionOwnerAnnotation, owner)
+	_, err = cs.LifecycleV1alpha1().LifecycleTransitions().Update(ctx, existing, metav1.UpdateOptions{})
 	return err
 }
 
+// enabledDuration returns d if enabled and zero otherwise, for options
+// set by a bool flag plus a duration flag, such as --verify-reschedule.
+func enabledDuration(enabled bool, d time.Duration) time.Duration {
+	if !enabled {
+		return 0
+	}
+	return d
+}
+
+// serveSLM serves the SLM plugin and DrainControl APIs of drainService on
+// a Unix socket at endpoint, with the given server options.
+func serveSLM(logger klog.Logger, endpoint string, drainService *driver.DrainService, enableReflection bool, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	lis, err := listen(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("listen SLM socket: %w", err)
+	}
+	server := grpc.NewServer(opts...)
+	slmpbv1alpha1.RegisterSLMPluginServer(server, drainService)
+	drainpbv1alpha1.RegisterDrainControlServer(server, drainService)
+	if enableReflection {
+		reflection.Register(server)
+	}
+	go func() {
+		logger.Info("SLM gRPC server started", "endpoint", endpoint)
+		logServeExit(logger, "SLM gRPC server", server.Serve(lis))
+	}()
+	return server, nil
+}
+
+// logServeExit logs why the gRPC server name stopped serving. Serve
+// returns nil or grpc.ErrServerStopped after Stop or GracefulStop, which
+// is a clean shutdown rather than a failure.
+func logServeExit(logger klog.Logger, name string, err error) {
+	if err == nil || errors.Is(err, grpc.ErrServerStopped) {
+		logger.Info("gRPC server stopped", "server", name)
+		return
+	}
+	logger.Error(err, "gRPC server failed", "server", name)
+}
+
+// serveRegistration serves the kubelet plugin registration API on a Unix
+// socket at socket, advertising slmEndpoint and supportedVersions for
+// driverName.
+func serveRegistration(logger klog.Logger, socket, driverName, slmEndpoint string, supportedVersions []string) (*grpc.Server, error) {
+	lis, err := listen(socket)
+	if err != nil {
+		return nil, fmt.Errorf("listen registration socket: %w", err)
+	}
+	server := grpc.NewServer()
+	registerapi.RegisterRegistrationServer(server, &registrationService{
+		driverName:        driverName,
+		endpoint:          slmEndpoint,
+		supportedVersions: supportedVersions,
+	})
+	go func() {
+		logger.Info("Registration server started", "socket", socket)
+		logServeExit(logger, "Registration gRPC server", server.Serve(lis))
+	}()
+	return server, nil
+}
+
+// serveHTTP serves handler on the TCP address addr.
+func serveHTTP(logger klog.Logger, addr string, handler http.Handler) (*http.Server, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen HTTP endpoint: %w", err)
+	}
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		logger.Info("HTTP server started", "endpoint", lis.Addr().String())
+		if err := server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(err, "HTTP server failed")
+		}
+	}()
+	return server, nil
+}
+
+// checkWritableDir creates dir if needed and verifies the process can
+// create files in it.
+func checkWritableDir(dir string) error {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return fmt.Errorf("create directory %s: %w", dir, err)
+	}
+	f, err := os.CreateTemp(dir, ".preflight-*")
+	if err != nil {
+		return fmt.Errorf("directory %s is not writable: %w", dir, err)
+	}
+	name := f.Name()
+	_ = f.Close()
+	return os.Remove(name)
+}
+
 // listen creates a Unix domain socket, removing any stale socket first.
 func listen(socketPath string) (net.Listener, error) {
 	if err := os.MkdirAll(filepath.Dir(socketPath), 0750); err != nil {
@@ -295,6 +1004,16 @@ func listen(socketPath string) (net.Listener, error) {
 	return net.Listen("unix", socketPath)
 }
 
+// removeSocket deletes a socket file after its server has stopped. Closing
+// the listener usually unlinks it already, so a missing file is fine.
+func removeSocket(logger klog.Logger, socketPath string) {
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		logger.Error(err, "Failed to remove socket", "socket", socketPath)
+		return
+	}
+	logger.V(3).Info("Removed socket", "socket", socketPath)
+}
+
 // Kubelet plugin registration
 
 type registrationService struct {
@@ -305,7 +1024,7 @@ type registrationService struct {
 }
 
 func (r *registrationService) GetInfo(ctx context.Context, req *registerapi.InfoRequest) (*registerapi.PluginInfo, error) {
-	klog.FromContext(ctx).Info("GetInfo called", "driver", r.driverName)
+	klog.FromContext(ctx).Info("GetInfo called", "driver", r.driverName, "version", version())
 	return &registerapi.PluginInfo{
 		Type:              registerapi.SLMPlugin,
 		Name:              r.driverName,
@@ -316,9 +1035,13 @@ func (r *registrationService) GetInfo(ctx context.Context, req *registerapi.Info
 
 func (r *registrationService) NotifyRegistrationStatus(ctx context.Context, status *registerapi.RegistrationStatus) (*registerapi.RegistrationStatusResponse, error) {
 	if !status.PluginRegistered {
+		metrics.Registered.Set(0)
+		metrics.RegistrationAttemptsTotal.WithLabelValues("failure").Inc()
 		klog.FromContext(ctx).Error(nil, "Registration failed", "error", status.Error)
 		return nil, fmt.Errorf("registration failed: %s", status.Error)
 	}
+	metrics.Registered.Set(1)
+	metrics.RegistrationAttemptsTotal.WithLabelValues("success").Inc()
 	klog.FromContext(ctx).Info("Successfully registered with kubelet")
 	return &registerapi.RegistrationStatusResponse{}, nil
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+)
+
+// configMapFlags maps each key accepted in the --config-configmap
+// ConfigMap to the flag it provides a default for. Values use the same
+// syntax as the flag, e.g.:
+//
+//	data:
+//	  gracePeriod: "60"
+//	  evictionTimeout: "45s"
+//	  excludedNamespaces: "kube-system,monitoring"
+//	  podSelector: "drain.example.com/skip!=true"
+var configMapFlags = map[string]string{
+	"gracePeriod":        "grace-period",
+	"evictionTimeout":    "eviction-timeout",
+	"excludedNamespaces": "excluded-namespaces",
+	"podSelector":        "pod-selector",
+}
+
+// configMapScratchFlags returns fresh flags of the same types as the
+// ConfigMap-backed flags, so values can be parsed without touching the
+// real ones.
+func configMapScratchFlags() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("configmap", pflag.ContinueOnError)
+	fs.Int64("grace-period", 0, "")
+	fs.Duration("eviction-timeout", 0, "")
+	fs.StringSlice("excluded-namespaces", nil, "")
+	fs.String("pod-selector", "", "")
+	return fs
+}
+
+// applyConfigMap reads the ConfigMap named by ref ("<namespace>/<name>") and
+// sets every flag it configures except those in pinned, which were set on
+// the command line. Unknown keys and values the flag cannot parse are
+// rejected, and then no flag is changed. A list replaces the flag's
+// current value rather than adding to it. A key removed from the
+// ConfigMap leaves its flag unchanged.
+func applyConfigMap(ctx context.Context, cs kubernetes.Interface, ref string, fs *pflag.FlagSet, pinned sets.Set[string]) error {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" {
+		return fmt.Errorf("invalid --config-configmap %q: must be <namespace>/<name>", ref)
+	}
+
+	cm, err := cs.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get ConfigMap %s: %w", ref, err)
+	}
+
+	keys := make([]string, 0, len(cm.Data))
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// Parse everything first, so a bad key leaves every flag as it was.
+	scratch := configMapScratchFlags()
+	var flagNames []string
+	for _, key := range keys {
+		flagName, ok := configMapFlags[key]
+		if !ok {
+			return fmt.Errorf("ConfigMap %s: unknown key %q", ref, key)
+		}
+		if pinned.Has(flagName) {
+			continue
+		}
+		if err := scratch.Set(flagName, cm.Data[key]); err != nil {
+			return fmt.Errorf("ConfigMap %s: invalid %s: %w", ref, key, err)
+		}
+		flagNames = append(flagNames, flagName)
+	}
+	if selector, err := scratch.GetString("pod-selector"); err == nil && scratch.Changed("pod-selector") {
+		if _, err := parsePodSelector(selector); err != nil {
+			return fmt.Errorf("ConfigMap %s: %w", ref, err)
+		}
+	}
+
+	for _, flagName := range flagNames {
+		parsed := scratch.Lookup(flagName).Value
+		if slice, ok := fs.Lookup(flagName).Value.(pflag.SliceValue); ok {
+			// Set on a slice flag that was set before appends.
+			if err := slice.Replace(parsed.(pflag.SliceValue).GetSlice()); err != nil {
+				return fmt.Errorf("ConfigMap %s: set %s: %w", ref, flagName, err)
+			}
+			continue
+		}
+		if err := fs.Set(flagName, parsed.String()); err != nil {
+			return fmt.Errorf("ConfigMap %s: set %s: %w", ref, flagName, err)
+		}
+	}
+	return nil
+}
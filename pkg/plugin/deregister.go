@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	lifecycleapi "k8s.io/api/lifecycle/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/component-base/term"
+)
+
+// newDeregisterCommand creates the deregister subcommand, the uninstall
+// path for a node. Removing the registration socket makes the kubelet's
+// plugin watcher deregister the driver; the SLM socket is removed too.
+// With --delete-transitions it also deletes the LifecycleTransitions this
+// driver published. It is meant to run after the driver has stopped.
+func newDeregisterCommand(driverName *string, clientset func() kubernetes.Interface, transitions func() (drain, uncordon *lifecycleapi.LifecycleTransition, err error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister",
+		Short: "Deregister the driver from the kubelet and remove what it published",
+		Args:  cobra.ExactArgs(0),
+	}
+	flagSets := cliflag.NamedFlagSets{}
+	fs := flagSets.FlagSet("kubelet")
+	kubeletRegistryDir := fs.String("plugin-registration-path", DefaultKubeletRegistryDir, "kubelet plugin registration directory")
+	kubeletPluginsDir := fs.String("datadir", DefaultKubeletPluginsDir, "kubelet plugins base directory")
+	slmSocketName := fs.String("slm-socket-name", "slm.sock", "File name of the SLM socket in the driver's --datadir subdirectory.")
+	regSocketName := fs.String("registration-socket-name", "", "File name of the registration socket in --plugin-registration-path (default \"<driver-name>-reg.sock\").")
+	fs = flagSets.FlagSet("deregister")
+	deleteTransitions := fs.Bool("delete-transitions", false, "Also delete the LifecycleTransitions this driver published. Other nodes' drivers stop working too, so use it when uninstalling from the whole cluster.")
+
+	for _, f := range flagSets.FlagSets {
+		cmd.Flags().AddFlagSet(f)
+	}
+	cols, _, _ := term.TerminalSize(cmd.OutOrStdout())
+	cliflag.SetUsageAndHelpFunc(cmd, flagSets, cols)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if *regSocketName == "" {
+			*regSocketName = *driverName + "-reg.sock"
+		}
+		sockets := []string{
+			filepath.Join(*kubeletRegistryDir, *regSocketName),
+			path.Join(*kubeletPluginsDir, *driverName, *slmSocketName),
+		}
+		for _, socket := range sockets {
+			err := os.Remove(socket)
+			switch {
+			case os.IsNotExist(err):
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: already gone\n", socket)
+			case err != nil:
+				return fmt.Errorf("remove socket: %w", err)
+			default:
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: removed\n", socket)
+			}
+		}
+
+		if !*deleteTransitions {
+			return nil
+		}
+		drain, uncordon, err := transitions()
+		if err != nil {
+			return err
+		}
+		for _, lt := range []*lifecycleapi.LifecycleTransition{drain, uncordon} {
+			deleted, err := deleteOwnedTransition(cmd.Context(), clientset(), lt)
+			if err != nil {
+				return fmt.Errorf("delete LifecycleTransition %s: %w", lt.Name, err)
+			}
+			if deleted {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: deleted\n", lt.Name)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: not found or not owned by this driver, left alone\n", lt.Name)
+			}
+		}
+		return nil
+	}
+	return cmd
+}
+
+// deleteOwnedTransition deletes the LifecycleTransition named like lt if
+// its TransitionOwnerAnnotation matches lt's. It reports whether it
+// deleted it.
+func deleteOwnedTransition(ctx context.Context, cs kubernetes.Interface, lt *lifecycleapi.LifecycleTransition) (bool, error) {
+	client := cs.LifecycleV1alpha1().LifecycleTransitions()
+	existing, err := client.Get(ctx, lt.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if existing.Annotations[TransitionOwnerAnnotation] != lt.Annotations[TransitionOwnerAnnotation] {
+		return false, nil
+	}
+	err = client.Delete(ctx, lt.Name, metav1.DeleteOptions{
+		Preconditions: metav1.NewUIDPreconditions(string(existing.UID)),
+	})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
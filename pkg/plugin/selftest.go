@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/klog/v2"
+	registerapi "k8s.io/kubelet/pkg/apis/pluginregistration/v1"
+	slmpbv1alpha1 "k8s.io/kubelet/pkg/apis/slm/v1alpha1"
+
+	"k8s.io/kubectl-server-side-drain/pkg/driver"
+)
+
+const (
+	// selfTestNodeName is the node the self-test uncordons in its fake
+	// cluster.
+	selfTestNodeName = "selftest-node"
+	// selfTestTimeout bounds the whole self-test.
+	selfTestTimeout = 30 * time.Second
+)
+
+// newSelfTestCommand creates the selftest subcommand. It needs no cluster
+// or kubelet, so it is usable as a CI or pre-flight check.
+func newSelfTestCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "selftest",
+		Short: "Check socket creation and gRPC wiring against a fake cluster",
+		Args:  cobra.ExactArgs(0),
+		// Override the root hook, which builds a client for a real cluster.
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), selfTestTimeout)
+			defer cancel()
+			if err := runSelfTest(ctx); err != nil {
+				return fmt.Errorf("selftest failed: %w", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "selftest passed")
+			return nil
+		},
+	}
+}
+
+// runSelfTest starts the SLM and registration servers in a temporary
+// directory, looks the driver up through GetInfo like the kubelet would,
+// and runs the uncordon transition against a fake clientset.
+func runSelfTest(ctx context.Context) error {
+	logger := klog.FromContext(ctx)
+
+	dir, err := os.MkdirTemp("", "kssd-selftest-")
+	if err != nil {
+		return fmt.Errorf("create temp directory: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	clientset := fake.NewClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: selfTestNodeName},
+		Spec:       corev1.NodeSpec{Unschedulable: true},
+	})
+	drainService := driver.NewDrainService(clientset, selfTestNodeName, driver.Config{})
+
+	slmEndpoint := filepath.Join(dir, "slm.sock")
+	slmServer, err := serveSLM(logger, slmEndpoint, drainService, false)
+	if err != nil {
+		return err
+	}
+	defer slmServer.Stop()
+
+	regSocket := filepath.Join(dir, DriverName+"-reg.sock")
+	regServer, err := serveRegistration(logger, regSocket, DriverName, slmEndpoint, []string{slmpbv1alpha1.SLMPluginService})
+	if err != nil {
+		return err
+	}
+	defer regServer.Stop()
+
+	regConn, err := dialUnix(regSocket)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = regConn.Close()
+	}()
+	info, err := registerapi.NewRegistrationClient(regConn).GetInfo(ctx, &registerapi.InfoRequest{})
+	if err != nil {
+		return fmt.Errorf("GetInfo: %w", err)
+	}
+	if info.Type != registerapi.SLMPlugin || info.Name != DriverName || info.Endpoint != slmEndpoint {
+		return fmt.Errorf("GetInfo returned type=%q name=%q endpoint=%q", info.Type, info.Name, info.Endpoint)
+	}
+
+	slmConn, err := dialUnix(info.Endpoint)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = slmConn.Close()
+	}()
+	slm := slmpbv1alpha1.NewSLMPluginClient(slmConn)
+
+	resp, err := slm.StartLifecycleTransition(ctx, &slmpbv1alpha1.StartLifecycleTransitionRequest{
+		TransitionName: MaintenanceCompleteTransitionName,
+		EventName:      "selftest",
+		NodeName:       selfTestNodeName,
+		Start:          driver.Uncordoning,
+		End:            driver.MaintenanceComplete,
+	})
+	if err != nil {
+		return fmt.Errorf("StartLifecycleTransition: %w", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("StartLifecycleTransition: %s", resp.Error)
+	}
+
+	resp, err = slm.EndLifecycleTransition(ctx, &slmpbv1alpha1.EndLifecycleTransitionRequest{
+		TransitionName: MaintenanceCompleteTransitionName,
+		EventName:      "selftest",
+		NodeName:       selfTestNodeName,
+		Start:          driver.Uncordoning,
+		End:            driver.MaintenanceComplete,
+	})
+	if err != nil {
+		return fmt.Errorf("EndLifecycleTransition: %w", err)
+	}
+	if resp.LifecycleCondition != driver.MaintenanceComplete {
+		return fmt.Errorf("EndLifecycleTransition returned %q, want %q (error %q)", resp.LifecycleCondition, driver.MaintenanceComplete, resp.Error)
+	}
+	return nil
+}
+
+// dialUnix opens a client connection to the gRPC server on a Unix socket.
+func dialUnix(socket string) (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", socket, err)
+	}
+	return conn, nil
+}
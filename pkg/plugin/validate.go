@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	lifecycleapi "k8s.io/api/lifecycle/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// newValidateTransitionsCommand creates the validate-transitions
+// subcommand. It builds the LifecycleTransitions exactly as kubelet-plugin
+// would and submits them with a server-side dry run, so admission and
+// validation problems show up without creating anything or starting any
+// server.
+func newValidateTransitionsCommand(clientset func() kubernetes.Interface, transitions func() (drain, uncordon *lifecycleapi.LifecycleTransition, err error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-transitions",
+		Short: "Dry-run the LifecycleTransitions kubelet-plugin would publish",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cs := clientset()
+			if err := checkLifecycleAPI(cs); err != nil {
+				return err
+			}
+			drain, uncordon, err := transitions()
+			if err != nil {
+				return err
+			}
+			failed := false
+			for _, lt := range []*lifecycleapi.LifecycleTransition{drain, uncordon} {
+				if err := dryRunTransition(cmd.Context(), cs, lt); err != nil {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: rejected: %v\n", lt.Name, err)
+					failed = true
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: ok\n", lt.Name)
+			}
+			if failed {
+				return errors.New("some LifecycleTransitions would be rejected")
+			}
+			return nil
+		},
+	}
+}
+
+// dryRunTransition submits lt with a server-side dry run. A transition
+// that already exists is validated as an update of it instead.
+func dryRunTransition(ctx context.Context, cs kubernetes.Interface, lt *lifecycleapi.LifecycleTransition) error {
+	client := cs.LifecycleV1alpha1().LifecycleTransitions()
+	_, err := client.Create(ctx, lt, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	existing, err := client.Get(ctx, lt.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	existing.Spec = lt.Spec
+	metav1.SetMetaDataAnnotation(&existing.ObjectMeta, TransitionOwnerAnnotation, lt.Annotations[TransitionOwnerAnnotation])
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	return err
+}